@@ -0,0 +1,230 @@
+package collection
+
+// ARCCache implements an adaptive replacement cache (ARC).
+// It keeps the items used once and the items used several times in two lists, and it remembers
+// the keys recently evicted from both of them to adapt the room given to each list to the workload.
+// It resists scans better than a pure LRU cache: a scan of new keys will not evict the items used frequently.
+//
+// Looking up a key evicted recently is still a miss, the adaptation happens when the key is added again.
+type ARCCache[K comparable, V any] struct {
+	cacheRecorder[K, V]
+	size int
+	// target size of `recent`
+	p int
+	// recent holds the items used once, frequent the items used at least twice. Most recent first.
+	recent, frequent DLList[*pentry[K, V]]
+	items            map[K]*Element[*pentry[K, V]]
+	// recentGhosts and frequentGhosts hold the keys recently evicted from `recent` and `frequent`.
+	recentGhosts, frequentGhosts DLList[K]
+	ghosts                       map[K]arcGhost[K]
+}
+
+// arcGhost is a key in one of the ghost lists.
+type arcGhost[K any] struct {
+	elem     *Element[K]
+	frequent bool
+}
+
+const (
+	arcRecent = iota
+	arcFrequent
+)
+
+// NewARCCache creates a new ARCCache.
+// The size must be positive, otherwise it panics.
+func NewARCCache[K comparable, V any](size int) *ARCCache[K, V] {
+	mustBePositive(size)
+	return &ARCCache[K, V]{
+		size:   size,
+		items:  make(map[K]*Element[*pentry[K, V]]),
+		ghosts: make(map[K]arcGhost[K]),
+	}
+}
+
+// Clear removes all items from the cache.
+func (c *ARCCache[K, V]) Clear() {
+	cleared := c.items
+	c.p = 0
+	c.recent.Clear()
+	c.frequent.Clear()
+	c.recentGhosts.Clear()
+	c.frequentGhosts.Clear()
+	c.items = make(map[K]*Element[*pentry[K, V]])
+	c.ghosts = make(map[K]arcGhost[K])
+	for key, elem := range cleared {
+		c.evicted(key, elem.Value().val, EvictedDeleted)
+	}
+}
+
+// Contains checks if the key is in the cache, without counting it as an access.
+func (c *ARCCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Delete removes the key from the cache. It returns false if the key was not in the cache.
+func (c *ARCCache[K, V]) Delete(key K) bool {
+	elem, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.list(elem.Value()).Remove(elem)
+	delete(c.items, key)
+	c.evicted(key, elem.Value().val, EvictedDeleted)
+	return true
+}
+
+// Get returns the value for the given key if present in the cache.
+func (c *ARCCache[K, V]) Get(key K) (val V, ok bool) {
+	elem, ok := c.items[key]
+	c.recordLookup(key, ok)
+	if !ok {
+		return val, ok
+	}
+	c.promote(elem)
+	return elem.Value().val, ok
+}
+
+// GetOrAdd returns the value for the given key if present in the cache.
+// If not, it adds the value returned by f and returns it.
+func (c *ARCCache[K, V]) GetOrAdd(key K, f func() V) V {
+	if val, ok := c.Get(key); ok {
+		return val
+	}
+	val := c.load(key, f)
+	c.Put(key, val)
+	return val
+}
+
+// IsEmpty returns true if the cache is empty.
+func (c *ARCCache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// IsFull returns true if the cache is full.
+func (c *ARCCache[K, V]) IsFull() bool {
+	return len(c.items) >= c.size
+}
+
+// Iter returns an iterator over the key/value pairs in the cache.
+// The items used several times are returned first, each list from the most recently used to the least one.
+func (c *ARCCache[K, V]) Iter() Iterator2[K, V] {
+	return iterEntries(&c.frequent, &c.recent)
+}
+
+// Len returns the number of items in the cache.
+func (c *ARCCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Peek returns the value for the given key if present in the cache, without counting it as an access.
+func (c *ARCCache[K, V]) Peek(key K) (val V, ok bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return val, ok
+	}
+	return elem.Value().val, ok
+}
+
+// Put adds the given key-value pair to the cache, it counts as an access of the key.
+func (c *ARCCache[K, V]) Put(key K, val V) {
+	c.recordPut(key)
+	if elem, ok := c.items[key]; ok {
+		elem.Value().val = val
+		c.promote(elem)
+		return
+	}
+	if ghost, ok := c.ghosts[key]; ok {
+		// the key was evicted recently, adapt the target size of `recent` and add it to `frequent`
+		c.readmit(key, ghost)
+		c.add(key, val, arcFrequent)
+		return
+	}
+	recent_keys := c.recent.Len() + c.recentGhosts.Len()
+	all_keys := recent_keys + c.frequent.Len() + c.frequentGhosts.Len()
+	switch {
+	case recent_keys >= c.size && c.recent.Len() < c.size:
+		c.forgetOldest(&c.recentGhosts)
+		c.replace(false)
+	case recent_keys >= c.size && c.recent.Len() > 0:
+		oldest := c.recent.BackElement()
+		c.recent.Remove(oldest)
+		delete(c.items, oldest.Value().key)
+		c.evicted(oldest.Value().key, oldest.Value().val, EvictedCapacity)
+	case all_keys >= c.size:
+		if all_keys >= 2*c.size && c.frequentGhosts.Len() > 0 {
+			c.forgetOldest(&c.frequentGhosts)
+		}
+		c.replace(false)
+	}
+	c.add(key, val, arcRecent)
+}
+
+// Stats returns a snapshot of the statistics of the cache. The weight is the number of items.
+func (c *ARCCache[K, V]) Stats() CacheStats {
+	return c.snapshot(len(c.items))
+}
+
+// add adds a new entry at the front of the list.
+func (c *ARCCache[K, V]) add(key K, val V, list int) {
+	entry := &pentry[K, V]{key: key, val: val, list: list}
+	c.items[key] = c.list(entry).PushFront(entry)
+}
+
+// forgetOldest removes the oldest key of the ghost list.
+func (c *ARCCache[K, V]) forgetOldest(ghosts *DLList[K]) {
+	key, _ := ghosts.PopBack()
+	delete(c.ghosts, key)
+}
+
+// list returns the list holding the entry.
+func (c *ARCCache[K, V]) list(entry *pentry[K, V]) *DLList[*pentry[K, V]] {
+	if entry.list == arcRecent {
+		return &c.recent
+	}
+	return &c.frequent
+}
+
+// promote moves the entry at the front of `frequent`.
+func (c *ARCCache[K, V]) promote(elem *Element[*pentry[K, V]]) {
+	entry := elem.Value()
+	if entry.list == arcFrequent {
+		c.frequent.MoveToFront(elem)
+		return
+	}
+	c.recent.Remove(elem)
+	entry.list = arcFrequent
+	c.items[entry.key] = c.frequent.PushFront(entry)
+}
+
+// readmit adapts the target size of `recent` for a key found in the ghost lists and forgets the key.
+func (c *ARCCache[K, V]) readmit(key K, ghost arcGhost[K]) {
+	if ghost.frequent {
+		// a key evicted from `frequent` is requested again: `frequent` deserves more room
+		c.p = max(0, c.p-max(c.recentGhosts.Len()/c.frequentGhosts.Len(), 1))
+		c.frequentGhosts.Remove(ghost.elem)
+	} else {
+		c.p = min(c.size, c.p+max(c.frequentGhosts.Len()/c.recentGhosts.Len(), 1))
+		c.recentGhosts.Remove(ghost.elem)
+	}
+	delete(c.ghosts, key)
+	c.replace(ghost.frequent)
+}
+
+// replace makes room for a new item, if the cache is full, evicting from `recent` or `frequent`
+// depending on the target size `p`, the evicted key is remembered in the corresponding ghost list.
+func (c *ARCCache[K, V]) replace(inFrequentGhosts bool) {
+	if len(c.items) < c.size {
+		return
+	}
+	list, ghosts, frequent := &c.frequent, &c.frequentGhosts, true
+	if r := c.recent.Len(); r > 0 && (r > c.p || (inFrequentGhosts && r == c.p) || c.frequent.Len() == 0) {
+		list, ghosts, frequent = &c.recent, &c.recentGhosts, false
+	}
+	oldest := list.BackElement()
+	list.Remove(oldest)
+	entry := oldest.Value()
+	delete(c.items, entry.key)
+	c.ghosts[entry.key] = arcGhost[K]{elem: ghosts.PushFront(entry.key), frequent: frequent}
+	c.evicted(entry.key, entry.val, EvictedCapacity)
+}
@@ -0,0 +1,41 @@
+package collection
+
+import "testing"
+
+func TestARCCacheResistsScans(t *testing.T) {
+	cache := NewARCCache[int, int](10)
+	for range 2 {
+		for i := range 5 {
+			cache.Put(i, i)
+			cache.Get(i)
+		}
+	}
+	for i := 100; i < 200; i++ {
+		cache.Put(i, i)
+	}
+	for i := range 5 {
+		if !cache.Contains(i) {
+			t.Errorf("cache.Contains(%d) = %t, want %t", i, false, true)
+		}
+	}
+}
+
+func TestARCCacheAdaptsToRecency(t *testing.T) {
+	cache := NewARCCache[int, int](4)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+	cache.Get(1) // 1 goes in the frequent list
+	cache.Put(4, 4)
+	cache.Put(5, 5) // 2 becomes a ghost
+	cache.Put(2, 2) // hit in the recent ghosts, the recent list grows
+	if v, ok := cache.Get(2); !ok || v != 2 {
+		t.Errorf("cache.Get(2) = %d, %t, want %d, %t", v, ok, 2, true)
+	}
+	if cache.p == 0 {
+		t.Errorf("cache.p = %d, want more than %d", cache.p, 0)
+	}
+	if cache.Len() != 4 {
+		t.Errorf("cache.Len() = %d, want %d", cache.Len(), 4)
+	}
+}
@@ -1,29 +1,283 @@
 package collection
 
+import (
+	"iter"
+	"time"
+)
+
+// Cache is the common interface of the caches of this package, which differ by the eviction policy.
+// The caches are not safe for concurrent use.
+type Cache[K comparable, V any] interface {
+	// Clear removes all items from the cache.
+	Clear()
+	// Contains checks if the key is in the cache, without counting it as an access.
+	Contains(key K) bool
+	// Delete removes the key from the cache. It returns false if the key was not in the cache.
+	Delete(key K) bool
+	// Get returns the value for the given key if present in the cache.
+	Get(key K) (V, bool)
+	// GetOrAdd returns the value for the given key if present in the cache.
+	// If not, it adds the value returned by f and returns it.
+	GetOrAdd(key K, f func() V) V
+	// IsEmpty returns true if the cache is empty.
+	IsEmpty() bool
+	// IsFull returns true if the cache is full, the next new key will evict an item.
+	IsFull() bool
+	// Iter returns an iterator over the key/value pairs in the cache, the order depends on the eviction policy.
+	Iter() Iterator2[K, V]
+	// Len returns the number of items in the cache.
+	Len() int
+	// OnEvict registers a callback that is called with every item removed from the cache.
+	OnEvict(f func(key K, val V, reason EvictionReason))
+	// Peek returns the value for the given key if present in the cache, without counting it as an access.
+	Peek(key K) (V, bool)
+	// Put adds the given key-value pair to the cache.
+	Put(key K, val V)
+	// SetHooks registers the functions called on the events of the cache.
+	SetHooks(hooks CacheHooks[K])
+	// Stats returns a snapshot of the statistics of the cache.
+	Stats() CacheStats
+}
+
+var (
+	_ Cache[int, int] = (*LRUCache[int, int])(nil)
+	_ Cache[int, int] = (*LFUCache[int, int])(nil)
+	_ Cache[int, int] = (*ARCCache[int, int])(nil)
+	_ Cache[int, int] = (*TwoQueueCache[int, int])(nil)
+	_ Cache[int, int] = (*TinyLFUCache[int, int])(nil)
+)
+
 // LRUCache implements a least recently used cache
+//
+// Items can expire after a time-to-live, either a default one for the cache (see `SetTTL`)
+// or one for each item (see `PutWithTTL`). Expired items are removed lazily when they are looked up,
+// or explicitly with `PurgeExpired`. Until then they count in `Len` and they are iterated.
+//
+// The capacity is measured in number of items, unless the cache is created with `NewWeightedCache`,
+// in which case it's measured in the weight of the items returned by a weigher function.
 type LRUCache[K comparable, V any] struct {
-	size   int
-	head   *cnode[K, V]
-	tail   *cnode[K, V]
-	cached map[K]*cnode[K, V]
+	cacheRecorder[K, V]
+	// size is the capacity of the cache, in weight if `weigher` is set, in number of items otherwise
+	size    int
+	head    *cnode[K, V]
+	tail    *cnode[K, V]
+	cached  map[K]*cnode[K, V]
+	ttl     time.Duration
+	now     func() time.Time
+	weigher func(key K, val V) int
+	weight  int
+}
+
+// EvictionReason tells why an item was removed from a cache.
+type EvictionReason int
+
+const (
+	// EvictedCapacity means the item was removed to make room for a new one, or because the cache was resized.
+	EvictedCapacity EvictionReason = iota
+	// EvictedDeleted means the item was removed explicitly, by `Delete`, `RemoveOldest` or `Clear`.
+	EvictedDeleted
+	// EvictedExpired means the item was removed because its time-to-live passed.
+	EvictedExpired
+)
+
+// String returns the name of the reason.
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictedCapacity:
+		return "capacity"
+	case EvictedDeleted:
+		return "deleted"
+	case EvictedExpired:
+		return "expired"
+	}
+	return "unknown"
+}
+
+// CacheStats is a snapshot of the statistics of a cache.
+type CacheStats struct {
+	// Hits is the number of lookups that found the key.
+	Hits uint64
+	// Misses is the number of lookups that did not find the key.
+	Misses uint64
+	// Puts is the number of items added or replaced, including the ones added by a loader.
+	Puts uint64
+	// Evictions is the number of items removed by the cache, because of its capacity or their time-to-live.
+	Evictions uint64
+	// Deletes is the number of items removed explicitly, e.g. by `Delete` or `Clear`.
+	Deletes uint64
+	// Loads is the number of calls of the loaders, LoadTime the total time spent in them.
+	Loads    uint64
+	LoadTime time.Duration
+	// Weight is the current total weight of the items, the number of items if the cache is not weighted.
+	Weight int
+}
+
+// HitRatio returns the ratio of the lookups that found the key, 0 if there were no lookups.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// CacheHooks are functions called on the events of a cache, e.g. to forward them to a metrics system.
+// The nil functions are not called. The evictions are reported by the callback registered with `OnEvict`.
+type CacheHooks[K comparable] struct {
+	// OnHit is called when a lookup finds the key.
+	OnHit func(key K)
+	// OnMiss is called when a lookup does not find the key.
+	OnMiss func(key K)
+	// OnPut is called when an item is added or replaced.
+	OnPut func(key K)
+	// OnLoad is called after a loader returns, with the time it took and its error, if any.
+	OnLoad func(key K, took time.Duration, err error)
+}
+
+// cacheRecorder holds the statistics and the callbacks of a cache, it's embedded in all the caches.
+type cacheRecorder[K comparable, V any] struct {
+	onEvict func(key K, val V, reason EvictionReason)
+	hooks   CacheHooks[K]
+	stats   CacheStats
+}
+
+// OnEvict registers a callback that is called with every item removed from the cache,
+// together with the reason of the removal. It can be used to release the resources held by the values.
+// Replacing the value of a key with `Put` does not call the callback. Only one callback can be registered,
+// a nil callback removes the current one.
+func (r *cacheRecorder[K, V]) OnEvict(f func(key K, val V, reason EvictionReason)) {
+	r.onEvict = f
+}
+
+// SetHooks registers the functions called on the events of the cache, replacing the ones registered before.
+func (r *cacheRecorder[K, V]) SetHooks(hooks CacheHooks[K]) {
+	r.hooks = hooks
+}
+
+// evicted counts the removal and calls the registered callback, if any.
+func (r *cacheRecorder[K, V]) evicted(key K, val V, reason EvictionReason) {
+	if reason == EvictedDeleted {
+		r.stats.Deletes++
+	} else {
+		r.stats.Evictions++
+	}
+	if r.onEvict != nil {
+		r.onEvict(key, val, reason)
+	}
+}
+
+// load calls the loader `f` and records how long it took.
+func (r *cacheRecorder[K, V]) load(key K, f func() V) V {
+	start := time.Now()
+	val := f()
+	r.recordLoad(key, time.Since(start), nil)
+	return val
+}
+
+// recordLoad counts a call of a loader.
+func (r *cacheRecorder[K, V]) recordLoad(key K, took time.Duration, err error) {
+	r.stats.Loads++
+	r.stats.LoadTime += took
+	if r.hooks.OnLoad != nil {
+		r.hooks.OnLoad(key, took, err)
+	}
+}
+
+// recordLookup counts a hit if the key was found, a miss otherwise.
+func (r *cacheRecorder[K, V]) recordLookup(key K, found bool) {
+	if found {
+		r.stats.Hits++
+		if r.hooks.OnHit != nil {
+			r.hooks.OnHit(key)
+		}
+		return
+	}
+	r.stats.Misses++
+	if r.hooks.OnMiss != nil {
+		r.hooks.OnMiss(key)
+	}
+}
+
+// recordPut counts an item added or replaced.
+func (r *cacheRecorder[K, V]) recordPut(key K) {
+	r.stats.Puts++
+	if r.hooks.OnPut != nil {
+		r.hooks.OnPut(key)
+	}
+}
+
+// snapshot returns a copy of the statistics with the given current weight.
+func (r *cacheRecorder[K, V]) snapshot(weight int) CacheStats {
+	stats := r.stats
+	stats.Weight = weight
+	return stats
 }
 
 // NewLRUCache creates a new LRUCache.
 // If the size is 0 or negative, the cache is unbounded.
 func NewCache[K comparable, V any](size int) *LRUCache[K, V] {
-	return &LRUCache[K, V]{size: size, cached: make(map[K]*cnode[K, V])}
+	return &LRUCache[K, V]{size: size, cached: make(map[K]*cnode[K, V]), now: time.Now}
+}
+
+// NewWeightedCache creates a new LRUCache whose capacity is the total weight of the items,
+// the weight of an item is returned by `weigher`, e.g. the size in bytes of the value.
+// The least recently used items are evicted until the total weight fits the capacity.
+// An item heavier than the whole capacity is not added.
+//
+// The weigher is called once when the item is added, it must not return a negative weight.
+// If the capacity is 0 or negative, the cache is unbounded.
+func NewWeightedCache[K comparable, V any](capacity int, weigher func(key K, val V) int) *LRUCache[K, V] {
+	c := NewCache[K, V](capacity)
+	c.weigher = weigher
+	return c
+}
+
+// All returns an `iter.Seq2` over the key/value pairs in the cache.
+// The pairs are returned in the same order as `IterKeys`, from the most recently used to the least one.
+// Iterating does not change the order of the cache.
+func (c *LRUCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := c.head; n != nil; n = n.next {
+			if !yield(n.key, n.val) {
+				return
+			}
+		}
+	}
 }
 
 // Clear removes all items from the cache.
+// If an eviction callback is registered, it is called for every item.
 func (c *LRUCache[K, V]) Clear() {
+	cleared := c.head
 	c.cached = make(map[K]*cnode[K, V])
 	c.head = nil
 	c.tail = nil
+	c.weight = 0
+	for n := cleared; n != nil; n = n.next {
+		c.evicted(n.key, n.val, EvictedDeleted)
+	}
+}
+
+// Contains checks if the key is in the cache, without changing its recency.
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	_, ok := c.lookup(key)
+	return ok
+}
+
+// Delete removes the key from the cache. It returns false if the key was not in the cache.
+func (c *LRUCache[K, V]) Delete(key K) bool {
+	node, ok := c.cached[key]
+	if !ok {
+		return false
+	}
+	c.removeNode(node, EvictedDeleted)
+	return true
 }
 
 // Get returns the value for the given key if present in the cache.
 func (c *LRUCache[K, V]) Get(key K) (val V, ok bool) {
-	node, ok := c.cached[key]
+	node, ok := c.lookup(key)
+	c.recordLookup(key, ok)
 	if !ok {
 		return val, ok
 	}
@@ -32,19 +286,32 @@ func (c *LRUCache[K, V]) Get(key K) (val V, ok bool) {
 }
 
 // GetOrAdd returns the value for the given key if present in the cache.
-// If not, it adds the value returned bu f and returns the given value.
+// If not, it adds the value returned by f and returns it.
 func (c *LRUCache[K, V]) GetOrAdd(key K, f func() V) V {
 	node, ok := c.Get(key)
 	if ok {
 		return node
 	}
-	val := f()
+	val := c.load(key, f)
 	c.Put(key, val)
 	return val
 }
 
+// Iter returns an iterator over the key/value pairs in the cache.
+// The pairs are returned in the same order as `IterKeys`.
+func (c *LRUCache[K, V]) Iter() Iterator2[K, V] {
+	cur_node := c.head
+	return func() (k K, v V, ok bool) {
+		if cur_node == nil {
+			return k, v, false
+		}
+		k, v, cur_node = cur_node.key, cur_node.val, cur_node.next
+		return k, v, true
+	}
+}
+
 // IterKeys returns an iterator over the keys in the cache.
-// The keys are returned from the least recently used to the last one.
+// The keys are returned from the most recently used to the least one.
 func (c *LRUCache[K, V]) IterKeys() Iterator[K] {
 	cur_node := c.head
 	return func() (k K, ok bool) {
@@ -57,7 +324,7 @@ func (c *LRUCache[K, V]) IterKeys() Iterator[K] {
 }
 
 // IterVals returns an iterator over the values in the cache.
-// The values are returned from the least recently used to the last one.
+// The values are returned from the most recently used to the least one.
 func (c *LRUCache[K, V]) IterVals() Iterator[V] {
 	cur_node := c.head
 	return func() (v V, ok bool) {
@@ -70,8 +337,9 @@ func (c *LRUCache[K, V]) IterVals() Iterator[V] {
 }
 
 // IsFull returns true if the cache is full.
+// For a weighted cache it's true when the total weight reached the capacity.
 func (c *LRUCache[K, V]) IsFull() bool {
-	return len(c.cached) == c.size	&& c.size > 0
+	return c.weight >= c.size && c.size > 0
 }
 
 // IsEmpty returns true if the cache is empty.
@@ -84,25 +352,127 @@ func (c *LRUCache[K, V]) Len() int {
 	return len(c.cached)
 }
 
+// Peek returns the value for the given key if present in the cache, without changing its recency.
+func (c *LRUCache[K, V]) Peek(key K) (val V, ok bool) {
+	node, ok := c.lookup(key)
+	if !ok {
+		return val, ok
+	}
+	return node.val, ok
+}
+
+// PeekOldest returns the least recently used key/value pair, without changing its recency.
+// The third returned value is false if the cache is empty.
+func (c *LRUCache[K, V]) PeekOldest() (key K, val V, ok bool) {
+	if c.tail == nil {
+		return key, val, false
+	}
+	return c.tail.key, c.tail.val, true
+}
+
+// PurgeExpired removes all the expired items from the cache and returns how many were removed.
+func (c *LRUCache[K, V]) PurgeExpired() int {
+	now := c.now()
+	purged := 0
+	for n := c.head; n != nil; {
+		next := n.next
+		if n.expiredAt(now) {
+			c.removeNode(n, EvictedExpired)
+			purged++
+		}
+		n = next
+	}
+	return purged
+}
+
 // Put adds the given key-value pair to the cache.
+// The item expires after the default time-to-live of the cache, if set.
 func (c *LRUCache[K, V]) Put(key K, val V) {
+	c.PutWithTTL(key, val, c.ttl)
+}
+
+// PutWithTTL adds the given key-value pair to the cache, the item expires after `ttl`.
+// If `ttl` is 0 or negative the item does not expire.
+//
+// If the item is heavier than the capacity of a weighted cache it's not added,
+// and the previous value of the key, if any, is evicted.
+func (c *LRUCache[K, V]) PutWithTTL(key K, val V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.now().Add(ttl)
+	}
+	weight := c.weigh(key, val)
 	node, ok := c.cached[key]
+	if c.size > 0 && weight > c.size {
+		if ok {
+			c.removeNode(node, EvictedCapacity)
+		}
+		return
+	}
+	c.recordPut(key)
 	if ok {
-		node.val = val
+		c.weight += weight - node.weight
+		node.val, node.expires, node.weight = val, expires, weight
 		c.moveToHead(node)
-		return
+	} else {
+		node = &cnode[K, V]{key: key, val: val, expires: expires, weight: weight}
+		c.cached[key] = node
+		c.weight += weight
+		c.pushHead(node)
 	}
-	if c.size > 0 && len(c.cached) >= c.size {
-		c.removeTail()
+	// the new node is at the head and fits alone, so it's never evicted
+	for c.size > 0 && c.weight > c.size {
+		c.removeNode(c.tail, EvictedCapacity)
 	}
-	node = &cnode[K, V]{key: key, val: val}
-	c.cached[key] = node
-	// Add the first node
-	if c.head == nil {
-		c.head, c.tail = node, node
-		return
+}
+
+// RemoveOldest removes the least recently used key/value pair from the cache and returns it.
+// The third returned value is false if the cache is empty.
+func (c *LRUCache[K, V]) RemoveOldest() (key K, val V, ok bool) {
+	if c.tail == nil {
+		return key, val, false
+	}
+	node := c.tail
+	c.removeNode(node, EvictedDeleted)
+	return node.key, node.val, true
+}
+
+// Resize changes the size of the cache, evicting the least recently used items if they don't fit.
+// If the size is 0 or negative, the cache becomes unbounded. It returns the number of evicted items.
+// The size of a weighted cache is its capacity in weight.
+func (c *LRUCache[K, V]) Resize(size int) int {
+	c.size = size
+	evicted := 0
+	for size > 0 && c.weight > size {
+		c.removeNode(c.tail, EvictedCapacity)
+		evicted++
+	}
+	return evicted
+}
+
+// ReverseAll returns an `iter.Seq2` over the key/value pairs in the cache.
+// The pairs are returned in the same order as `ReverseIterKeys`, from the least recently used to the most recent one.
+func (c *LRUCache[K, V]) ReverseAll() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := c.tail; n != nil; n = n.prev {
+			if !yield(n.key, n.val) {
+				return
+			}
+		}
+	}
+}
+
+// ReverseIter returns an iterator over the key/value pairs in the cache.
+// The pairs are returned in the same order as `ReverseIterKeys`.
+func (c *LRUCache[K, V]) ReverseIter() Iterator2[K, V] {
+	cur_node := c.tail
+	return func() (k K, v V, ok bool) {
+		if cur_node == nil {
+			return k, v, false
+		}
+		k, v, cur_node = cur_node.key, cur_node.val, cur_node.prev
+		return k, v, true
 	}
-	c.moveToHead(node)
 }
 
 // ReverseIterKeys returns an iterator over the keys in the cache.
@@ -131,39 +501,133 @@ func (c *LRUCache[K, V]) ReverseIterVals() Iterator[V] {
 	}
 }
 
+// SetClock sets the function used to get the current time, by default `time.Now`.
+// It's useful to control the expiration of the items in tests.
+func (c *LRUCache[K, V]) SetClock(now func() time.Time) {
+	c.now = now
+}
+
+// SetTTL sets the default time-to-live of the items added with `Put` and `GetOrAdd`.
+// If `ttl` is 0 or negative, the items do not expire. The items already in the cache are not affected.
+func (c *LRUCache[K, V]) SetTTL(ttl time.Duration) {
+	c.ttl = ttl
+}
+
+// Stats returns a snapshot of the statistics of the cache.
+func (c *LRUCache[K, V]) Stats() CacheStats {
+	return c.snapshot(c.weight)
+}
+
+// Weight returns the total weight of the items in the cache.
+// If the cache is not weighted every item weighs 1, so it's the same as `Len`.
+func (c *LRUCache[K, V]) Weight() int {
+	return c.weight
+}
+
+// lookup returns the node of the key, removing it if it's expired.
+func (c *LRUCache[K, V]) lookup(key K) (*cnode[K, V], bool) {
+	node, ok := c.cached[key]
+	if !ok {
+		return nil, false
+	}
+	if node.expiredAt(c.now()) {
+		c.removeNode(node, EvictedExpired)
+		return nil, false
+	}
+	return node, true
+}
+
 func (c *LRUCache[K, V]) moveToHead(node *cnode[K, V]) {
 	if node == c.head {
 		return
 	}
-	if node == c.tail {
-		c.tail = node.prev
+	c.unlink(node)
+	c.pushHead(node)
+}
+
+// weigh returns the weight of the item, 1 if the cache is not weighted.
+func (c *LRUCache[K, V]) weigh(key K, val V) int {
+	if c.weigher == nil {
+		return 1
 	}
+	return c.weigher(key, val)
+}
+
+// pushHead links the node, which must not be linked, as the most recently used one.
+func (c *LRUCache[K, V]) pushHead(node *cnode[K, V]) {
+	node.prev = nil
+	node.next = c.head
+	if c.head != nil {
+		c.head.prev = node
+	} else {
+		c.tail = node
+	}
+	c.head = node
+}
+
+// removeNode removes the node from the cache and reports the eviction.
+func (c *LRUCache[K, V]) removeNode(node *cnode[K, V], reason EvictionReason) {
+	c.unlink(node)
+	delete(c.cached, node.key)
+	c.weight -= node.weight
+	c.evicted(node.key, node.val, reason)
+}
+
+// unlink removes the node from the recency list, the node is kept in the map.
+func (c *LRUCache[K, V]) unlink(node *cnode[K, V]) {
 	if node.prev != nil {
 		node.prev.next = node.next
+	} else {
+		c.head = node.next
 	}
 	if node.next != nil {
 		node.next.prev = node.prev
+	} else {
+		c.tail = node.prev
 	}
-	node.prev = nil
-	node.next = c.head
-	c.head.prev = node
-	c.head = node
+	node.prev, node.next = nil, nil
 }
 
-func (c *LRUCache[K, V]) removeTail() {
-	if c.tail == nil {
-		return
-	}
-	if c.tail.prev != nil {
-		c.tail.prev.next = nil
+type cnode[K comparable, V any] struct {
+	key     K
+	val     V
+	expires time.Time
+	weight  int
+	prev    *cnode[K, V]
+	next    *cnode[K, V]
+}
+
+// expiredAt checks if the node is expired at the given time. Nodes with a zero `expires` never expire.
+func (n *cnode[K, V]) expiredAt(now time.Time) bool {
+	return !n.expires.IsZero() && !now.Before(n.expires)
+}
+
+// pentry is an entry of the caches whose eviction policy moves the entries between several lists.
+type pentry[K comparable, V any] struct {
+	key K
+	val V
+	// list identifies the list of the cache holding the entry
+	list int
+}
+
+// iterEntries returns an iterator over the entries of the lists, one list after the other, from front to back.
+func iterEntries[K comparable, V any](lists ...*DLList[*pentry[K, V]]) Iterator2[K, V] {
+	var cur *Element[*pentry[K, V]]
+	return func() (k K, v V, ok bool) {
+		for cur == nil {
+			if len(lists) == 0 {
+				return k, v, false
+			}
+			cur, lists = lists[0].FrontElement(), lists[1:]
+		}
+		k, v, cur = cur.Value().key, cur.Value().val, cur.Next()
+		return k, v, true
 	}
-	delete(c.cached, c.tail.key)
-	c.tail = c.tail.prev
 }
 
-type cnode[K comparable, V any] struct {
-	key  K
-	val  V
-	prev *cnode[K, V]
-	next *cnode[K, V]
+// mustBePositive panics if the size of a cache is not positive.
+func mustBePositive(size int) {
+	if size <= 0 {
+		panic("collection: the size of the cache must be positive")
+	}
 }
@@ -2,6 +2,7 @@ package collection
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNewCacheWithLimit(t *testing.T) {
@@ -238,3 +239,360 @@ func TestCacheIsFullWithoutSize(t *testing.T) {
 		t.Errorf("cache.IsFull() = %t, want %t", cache.IsFull(), false)
 	}
 }
+
+func TestCacheAll(t *testing.T) {
+	cache := NewCache[int, int](3)
+	cache.Put(1, 4)
+	cache.Put(2, 5)
+	cache.Put(3, 6)
+	keys := []int{3, 2, 1}
+	ind := 0
+	for k, v := range cache.All() {
+		if k != keys[ind] || v != k+3 {
+			t.Errorf("cache.All()[%d] = %d, %d, want %d, %d", ind, k, v, keys[ind], keys[ind]+3)
+		}
+		ind++
+	}
+	ind = 2
+	for k := range cache.ReverseAll() {
+		if k != keys[ind] {
+			t.Errorf("cache.ReverseAll()[%d] = %d, want %d", 2-ind, k, keys[ind])
+		}
+		ind--
+	}
+}
+
+func TestCacheSizeOne(t *testing.T) {
+	cache := NewCache[int, int](1)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+	if cache.Len() != 1 || cache.IterKeys().Count() != 1 {
+		t.Errorf("cache.Len() = %d, want %d", cache.Len(), 1)
+	}
+	if v, ok := cache.Get(3); !ok || v != 3 {
+		t.Errorf("cache.Get(3) = %d, %t, want %d, %t", v, ok, 3, true)
+	}
+}
+
+func TestCacheDelete(t *testing.T) {
+	cache := NewCache[int, int](3)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+	if !cache.Delete(2) || cache.Delete(2) {
+		t.Errorf("cache.Delete(2) should succeed only once")
+	}
+	if cache.Contains(2) || cache.Len() != 2 {
+		t.Errorf("cache.Contains(2) = %t, cache.Len() = %d, want %t, %d", cache.Contains(2), cache.Len(), false, 2)
+	}
+	keys := cache.IterKeys().Collect()
+	if keys.Len() != 2 || keys[0] != 3 || keys[1] != 1 {
+		t.Errorf("cache.IterKeys() = %v, want [3 1]", keys)
+	}
+}
+
+func TestCachePeek(t *testing.T) {
+	cache := NewCache[int, int](2)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	if v, ok := cache.Peek(1); !ok || v != 1 {
+		t.Errorf("cache.Peek(1) = %d, %t, want %d, %t", v, ok, 1, true)
+	}
+	if k, v, ok := cache.PeekOldest(); !ok || k != 1 || v != 1 {
+		t.Errorf("cache.PeekOldest() = %d, %d, %t, want %d, %d, %t", k, v, ok, 1, 1, true)
+	}
+	cache.Put(3, 3) // Peek did not promote 1, so it's evicted
+	if cache.Contains(1) {
+		t.Errorf("cache.Contains(1) = %t, want %t", true, false)
+	}
+	if k, _, ok := cache.RemoveOldest(); !ok || k != 2 {
+		t.Errorf("cache.RemoveOldest() = %d, %t, want %d, %t", k, ok, 2, true)
+	}
+}
+
+func TestCacheResizeAndOnEvict(t *testing.T) {
+	cache := NewCache[int, int](4)
+	evicted := map[int]EvictionReason{}
+	cache.OnEvict(func(k, v int, reason EvictionReason) { evicted[k] = reason })
+	for i := 1; i <= 5; i++ {
+		cache.Put(i, i)
+	}
+	if n := cache.Resize(2); n != 2 {
+		t.Errorf("cache.Resize(2) = %d, want %d", n, 2)
+	}
+	cache.Delete(5)
+	for k, want := range map[int]EvictionReason{1: EvictedCapacity, 2: EvictedCapacity, 3: EvictedCapacity, 5: EvictedDeleted} {
+		if got, ok := evicted[k]; !ok || got != want {
+			t.Errorf("evicted[%d] = %v, %t, want %v", k, got, ok, want)
+		}
+	}
+	cache.Clear()
+	if evicted[4] != EvictedDeleted {
+		t.Errorf("evicted[4] = %v, want %v", evicted[4], EvictedDeleted)
+	}
+}
+
+// fakeClock is a manual clock to control the expiration of the items.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestCacheTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := NewCache[int, int](0)
+	cache.SetClock(clock.Now)
+	cache.SetTTL(time.Minute)
+	var expired []int
+	cache.OnEvict(func(k, v int, reason EvictionReason) {
+		if reason == EvictedExpired {
+			expired = append(expired, k)
+		}
+	})
+	cache.Put(1, 1)
+	cache.PutWithTTL(2, 2, time.Hour)
+	cache.PutWithTTL(3, 3, 0)
+	clock.Advance(time.Minute)
+	if _, ok := cache.Get(1); ok {
+		t.Errorf("cache.Get(1) = %t, want %t", ok, false)
+	}
+	if _, ok := cache.Peek(2); !ok {
+		t.Errorf("cache.Peek(2) = %t, want %t", ok, true)
+	}
+	clock.Advance(time.Hour)
+	if cache.Len() != 2 {
+		t.Errorf("cache.Len() = %d, want %d", cache.Len(), 2)
+	}
+	if n := cache.PurgeExpired(); n != 1 {
+		t.Errorf("cache.PurgeExpired() = %d, want %d", n, 1)
+	}
+	if !cache.Contains(3) {
+		t.Errorf("cache.Contains(3) = %t, want %t", false, true)
+	}
+	if len(expired) != 2 || expired[0] != 1 || expired[1] != 2 {
+		t.Errorf("expired = %v, want [1 2]", expired)
+	}
+}
+
+func TestCachePutResetsTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := NewCache[int, int](0)
+	cache.SetClock(clock.Now)
+	cache.PutWithTTL(1, 1, time.Second)
+	cache.Put(1, 2)
+	clock.Advance(time.Hour)
+	if v, ok := cache.Get(1); !ok || v != 2 {
+		t.Errorf("cache.Get(1) = %d, %t, want %d, %t", v, ok, 2, true)
+	}
+}
+
+func TestCacheImplementations(t *testing.T) {
+	caches := map[string]func(size int) Cache[int, int]{
+		"LRU":     func(size int) Cache[int, int] { return NewCache[int, int](size) },
+		"LFU":     func(size int) Cache[int, int] { return NewLFUCache[int, int](size) },
+		"ARC":     func(size int) Cache[int, int] { return NewARCCache[int, int](size) },
+		"2Q":      func(size int) Cache[int, int] { return NewTwoQueueCache[int, int](size) },
+		"TinyLFU": func(size int) Cache[int, int] { return NewTinyLFUCache[int, int](size) },
+	}
+	for name, newCache := range caches {
+		t.Run(name, func(t *testing.T) {
+			cache := newCache(10)
+			evicted := map[int]EvictionReason{}
+			cache.OnEvict(func(key, val int, reason EvictionReason) { evicted[key] = reason })
+			if !cache.IsEmpty() {
+				t.Errorf("cache.IsEmpty() = %t, want %t", false, true)
+			}
+			for i := range 100 {
+				cache.Put(i, i*10)
+				if cache.Len() > 10 {
+					t.Fatalf("cache.Len() = %d, want at most %d", cache.Len(), 10)
+				}
+				cache.Get(i)
+			}
+			if !cache.IsFull() {
+				t.Errorf("cache.IsFull() = %t, want %t", false, true)
+			}
+			if len(evicted) != 90 {
+				t.Errorf("len(evicted) = %d, want %d", len(evicted), 90)
+			}
+			keys := 0
+			cache.Iter().ForEach(func(key, val int) {
+				keys++
+				if val != key*10 || !cache.Contains(key) {
+					t.Errorf("cache.Iter() yielded %d, %d not in the cache", key, val)
+				}
+				if v, ok := cache.Peek(key); !ok || v != val {
+					t.Errorf("cache.Peek(%d) = %d, %t, want %d, %t", key, v, ok, val, true)
+				}
+			})
+			if keys != cache.Len() {
+				t.Errorf("cache.Iter() yielded %d keys, want %d", keys, cache.Len())
+			}
+			if v := cache.GetOrAdd(99, func() int { return -1 }); v != 990 {
+				t.Errorf("cache.GetOrAdd(99) = %d, want %d", v, 990)
+			}
+			if !cache.Delete(99) || cache.Delete(99) {
+				t.Errorf("cache.Delete(99) should succeed only once")
+			}
+			if evicted[99] != EvictedDeleted {
+				t.Errorf("reason of 99 = %v, want %v", evicted[99], EvictedDeleted)
+			}
+			if v, ok := cache.Get(99); ok {
+				t.Errorf("cache.Get(99) = %d, %t, want %d, %t", v, ok, 0, false)
+			}
+			if v := cache.GetOrAdd(99, func() int { return -1 }); v != -1 {
+				t.Errorf("cache.GetOrAdd(99) = %d, want %d", v, -1)
+			}
+			left := cache.Len()
+			evicted = map[int]EvictionReason{}
+			cache.Clear()
+			if !cache.IsEmpty() || len(evicted) != left {
+				t.Errorf("cache.Clear() left %d items and evicted %d, want 0 and %d", cache.Len(), len(evicted), left)
+			}
+		})
+	}
+}
+
+func TestWeightedCache(t *testing.T) {
+	cache := NewWeightedCache(10, func(key string, val []byte) int { return len(val) })
+	var evicted []string
+	cache.OnEvict(func(key string, val []byte, reason EvictionReason) { evicted = append(evicted, key) })
+	cache.Put("a", make([]byte, 4))
+	cache.Put("b", make([]byte, 4))
+	if cache.Weight() != 8 || cache.Len() != 2 {
+		t.Errorf("cache.Weight(), cache.Len() = %d, %d, want %d, %d", cache.Weight(), cache.Len(), 8, 2)
+	}
+	if cache.IsFull() {
+		t.Errorf("cache.IsFull() = %t, want %t", true, false)
+	}
+	cache.Get("a")
+	cache.Put("c", make([]byte, 7)) // b and then a are evicted
+	if cache.Weight() != 7 || cache.Len() != 1 || !cache.Contains("c") {
+		t.Errorf("cache.Weight(), cache.Len() = %d, %d, want %d, %d", cache.Weight(), cache.Len(), 7, 1)
+	}
+	if len(evicted) != 2 || evicted[0] != "b" || evicted[1] != "a" {
+		t.Errorf("evicted = %v, want %v", evicted, []string{"b", "a"})
+	}
+	cache.Put("c", make([]byte, 10))
+	if cache.Weight() != 10 || !cache.IsFull() {
+		t.Errorf("cache.Weight() = %d, want %d", cache.Weight(), 10)
+	}
+	cache.Put("c", make([]byte, 11)) // too heavy, the previous value is evicted
+	if cache.Weight() != 0 || cache.Contains("c") {
+		t.Errorf("cache.Weight() = %d, want %d", cache.Weight(), 0)
+	}
+	cache.Put("d", make([]byte, 11))
+	if !cache.IsEmpty() {
+		t.Errorf("cache.Len() = %d, want %d", cache.Len(), 0)
+	}
+}
+
+func TestWeightedCacheResizeAndDelete(t *testing.T) {
+	cache := NewWeightedCache(10, func(key int, val int) int { return val })
+	cache.Put(1, 3)
+	cache.Put(2, 3)
+	cache.Put(3, 3)
+	if evicted := cache.Resize(5); evicted != 2 || cache.Weight() != 3 {
+		t.Errorf("cache.Resize(5) = %d, weight %d, want %d, weight %d", evicted, cache.Weight(), 2, 3)
+	}
+	cache.Delete(3)
+	if cache.Weight() != 0 {
+		t.Errorf("cache.Weight() = %d, want %d", cache.Weight(), 0)
+	}
+	cache.Put(1, 2)
+	cache.Clear()
+	if cache.Weight() != 0 {
+		t.Errorf("cache.Weight() = %d, want %d", cache.Weight(), 0)
+	}
+}
+
+func TestCacheWeightWithoutWeigher(t *testing.T) {
+	cache := NewCache[int, int](2)
+	cache.Put(1, 10)
+	cache.Put(2, 20)
+	cache.Put(3, 30)
+	if cache.Weight() != cache.Len() {
+		t.Errorf("cache.Weight() = %d, want %d", cache.Weight(), cache.Len())
+	}
+}
+
+func TestCacheStatsAndHooks(t *testing.T) {
+	caches := map[string]Cache[int, int]{
+		"LRU":     NewCache[int, int](2),
+		"LFU":     NewLFUCache[int, int](2),
+		"ARC":     NewARCCache[int, int](2),
+		"2Q":      NewTwoQueueCache[int, int](2),
+		"TinyLFU": NewTinyLFUCache[int, int](2),
+	}
+	for name, cache := range caches {
+		t.Run(name, func(t *testing.T) {
+			var hits, misses, puts, loads int
+			cache.SetHooks(CacheHooks[int]{
+				OnHit:  func(key int) { hits++ },
+				OnMiss: func(key int) { misses++ },
+				OnPut:  func(key int) { puts++ },
+				OnLoad: func(key int, took time.Duration, err error) { loads++ },
+			})
+			cache.Put(1, 1)
+			cache.Put(2, 2)
+			cache.Get(1)
+			cache.Get(3)
+			cache.GetOrAdd(4, func() int { return 4 })
+			cache.Peek(1)
+			cache.Contains(1)
+			stats := cache.Stats()
+			want := CacheStats{Hits: 1, Misses: 2, Puts: 3, Evictions: 1, Loads: 1, LoadTime: stats.LoadTime, Weight: 2}
+			if stats != want {
+				t.Errorf("cache.Stats() = %+v, want %+v", stats, want)
+			}
+			if hits != 1 || misses != 2 || puts != 3 || loads != 1 {
+				t.Errorf("hooks called %d, %d, %d, %d times, want %d, %d, %d, %d", hits, misses, puts, loads, 1, 2, 3, 1)
+			}
+			if ratio := stats.HitRatio(); ratio != 1.0/3 {
+				t.Errorf("stats.HitRatio() = %f, want %f", ratio, 1.0/3)
+			}
+		})
+	}
+}
+
+func TestWeightedCacheStats(t *testing.T) {
+	cache := NewWeightedCache(10, func(key int, val int) int { return val })
+	cache.Put(1, 4)
+	cache.Put(2, 5)
+	if stats := cache.Stats(); stats.Weight != 9 || stats.Puts != 2 {
+		t.Errorf("cache.Stats() = %+v, want weight %d and %d puts", stats, 9, 2)
+	}
+	cache.Clear()
+	if stats := cache.Stats(); stats.Weight != 0 || stats.Evictions != 0 || stats.Deletes != 2 {
+		t.Errorf("cache.Stats() = %+v, want weight %d, %d evictions and %d deletes", stats, 0, 0, 2)
+	}
+}
+
+func TestCacheStatsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := NewCache[int, int](0)
+	cache.SetClock(clock.Now)
+	cache.PutWithTTL(1, 1, time.Second)
+	cache.Put(2, 2)
+	clock.Advance(time.Second)
+	cache.PurgeExpired()
+	cache.Delete(2)
+	if stats := cache.Stats(); stats.Evictions != 1 || stats.Deletes != 1 {
+		t.Errorf("cache.Stats() = %+v, want %d eviction and %d delete", stats, 1, 1)
+	}
+}
+
+func TestWeightedCacheRejectedPut(t *testing.T) {
+	cache := NewWeightedCache(10, func(key int, val int) int { return val })
+	puts := 0
+	cache.SetHooks(CacheHooks[int]{OnPut: func(key int) { puts++ }})
+	cache.Put(1, 5)
+	cache.Put(2, 11)
+	if stats := cache.Stats(); stats.Puts != 1 || puts != 1 {
+		t.Errorf("cache.Stats().Puts = %d, OnPut called %d times, want %d", stats.Puts, puts, 1)
+	}
+}
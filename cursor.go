@@ -0,0 +1,212 @@
+package collection
+
+// VecCursor is a bidirectional cursor over a `Vec`.
+// The cursor is either positioned at an item, or before the first item, or after the last item.
+// A new cursor is positioned before the first item, so `Next` returns the first item:
+//
+//	cur := vec.Cursor()
+//	for v, ok := cur.Next(); ok; v, ok = cur.Next() {
+//		cur.Set(v * 2)
+//	}
+//
+// The cursor shares the backing array with the slice, `Set` is visible in the slice.
+// If the slice is reallocated (Ex: by `append`) the cursor keeps working on the old backing array.
+type VecCursor[T any] struct {
+	vec Vec[T]
+	pos int
+}
+
+// Index returns the index of the current item.
+// It returns -1 before the first item and `Len()` after the last one.
+func (c *VecCursor[T]) Index() int {
+	return c.pos
+}
+
+// Next moves the cursor to the next item and returns it.
+// The second returned value is false if the cursor moved after the last item.
+func (c *VecCursor[T]) Next() (T, bool) {
+	if c.pos < len(c.vec) {
+		c.pos++
+	}
+	return c.Value()
+}
+
+// Peek returns the next item without moving the cursor.
+// The second returned value is false if there is no next item.
+func (c *VecCursor[T]) Peek() (T, bool) {
+	if c.pos+1 < len(c.vec) {
+		return c.vec[c.pos+1], true
+	}
+	return *new(T), false
+}
+
+// Prev moves the cursor to the previous item and returns it.
+// The second returned value is false if the cursor moved before the first item.
+func (c *VecCursor[T]) Prev() (T, bool) {
+	if c.pos >= 0 {
+		c.pos--
+	}
+	return c.Value()
+}
+
+// Seek moves the cursor to the item at index `i` and returns it.
+// If `i` is out of range, the cursor is moved before the first item or after the last one
+// and the second returned value is false.
+func (c *VecCursor[T]) Seek(i int) (T, bool) {
+	switch {
+	case i < 0:
+		c.pos = -1
+	case i > len(c.vec):
+		c.pos = len(c.vec)
+	default:
+		c.pos = i
+	}
+	return c.Value()
+}
+
+// Set replaces the current item with `v`.
+// It returns false if the cursor is not positioned at an item.
+func (c *VecCursor[T]) Set(v T) bool {
+	if c.pos < 0 || c.pos >= len(c.vec) {
+		return false
+	}
+	c.vec[c.pos] = v
+	return true
+}
+
+// Value returns the current item.
+// The second returned value is false if the cursor is not positioned at an item.
+func (c *VecCursor[T]) Value() (T, bool) {
+	if c.pos < 0 || c.pos >= len(c.vec) {
+		return *new(T), false
+	}
+	return c.vec[c.pos], true
+}
+
+// ListCursor is a bidirectional cursor over a `DLList`.
+// The cursor is either positioned at an element, or before the first element, or after the last element.
+// A new cursor is positioned before the first element, so `Next` returns the first element.
+//
+// Inserting and removing at the cursor are O(1) operations:
+//
+//	cur := list.Cursor()
+//	for v, ok := cur.Next(); ok; v, ok = cur.Next() {
+//		if v < 0 {
+//			cur.Remove()
+//		}
+//	}
+//
+// The list must not be modified by other means while using the cursor, except through other cursors
+// which do not remove the element the cursor is positioned at.
+type ListCursor[T any] struct {
+	list *DLList[T]
+	cur  *Element[T]
+	// end tells where the cursor is when `cur` is nil: after the last element if true, before the first otherwise.
+	end bool
+}
+
+// InsertAfter adds `v` after the current element, the cursor does not move.
+// It returns false if the cursor is not positioned at an element.
+func (c *ListCursor[T]) InsertAfter(v T) bool {
+	if c.cur == nil {
+		return false
+	}
+	c.list.insertAfter(c.cur, &Element[T]{value: v})
+	return true
+}
+
+// InsertBefore adds `v` before the current element, the cursor does not move.
+// It returns false if the cursor is not positioned at an element.
+func (c *ListCursor[T]) InsertBefore(v T) bool {
+	if c.cur == nil {
+		return false
+	}
+	c.list.insertBefore(c.cur, &Element[T]{value: v})
+	return true
+}
+
+// Next moves the cursor to the next element and returns it.
+// The second returned value is false if the cursor moved after the last element.
+func (c *ListCursor[T]) Next() (T, bool) {
+	switch {
+	case c.cur != nil:
+		c.cur = c.cur.next
+	case !c.end:
+		c.cur = c.list.head
+	}
+	if c.cur == nil {
+		c.end = true
+	}
+	return c.Value()
+}
+
+// Peek returns the next element without moving the cursor.
+// The second returned value is false if there is no next element.
+func (c *ListCursor[T]) Peek() (T, bool) {
+	switch {
+	case c.cur != nil && c.cur.next != nil:
+		return c.cur.next.value, true
+	case c.cur == nil && !c.end && c.list.head != nil:
+		return c.list.head.value, true
+	}
+	return *new(T), false
+}
+
+// Prev moves the cursor to the previous element and returns it.
+// The second returned value is false if the cursor moved before the first element.
+func (c *ListCursor[T]) Prev() (T, bool) {
+	switch {
+	case c.cur != nil:
+		c.cur = c.cur.prev
+	case c.end:
+		c.cur = c.list.tail
+	}
+	if c.cur == nil {
+		c.end = false
+	}
+	return c.Value()
+}
+
+// Remove removes the current element from the list and moves the cursor to the previous element,
+// so `Next` returns the element that followed the removed one.
+// It returns the removed value, the second returned value is false if the cursor is not positioned at an element.
+func (c *ListCursor[T]) Remove() (T, bool) {
+	if c.cur == nil {
+		return *new(T), false
+	}
+	n := c.cur
+	c.cur = n.prev
+	c.end = false
+	c.list.remove(n)
+	return n.value, true
+}
+
+// Seek moves the cursor to the element at position `i` and returns it, walking from the nearer end of the list.
+// If `i` is out of range, the cursor is moved before the first element or after the last one
+// and the second returned value is false.
+func (c *ListCursor[T]) Seek(i int) (T, bool) {
+	c.cur = c.list.element(i)
+	if c.cur == nil {
+		c.end = i >= 0
+	}
+	return c.Value()
+}
+
+// Set replaces the value of the current element with `v`.
+// It returns false if the cursor is not positioned at an element.
+func (c *ListCursor[T]) Set(v T) bool {
+	if c.cur == nil {
+		return false
+	}
+	c.cur.value = v
+	return true
+}
+
+// Value returns the value of the current element.
+// The second returned value is false if the cursor is not positioned at an element.
+func (c *ListCursor[T]) Value() (T, bool) {
+	if c.cur == nil {
+		return *new(T), false
+	}
+	return c.cur.value, true
+}
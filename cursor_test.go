@@ -0,0 +1,82 @@
+package collection
+
+import "testing"
+
+func TestVecCursor(t *testing.T) {
+	a := Vec[int]{1, 2, 3}
+	cur := a.Cursor()
+	if v, ok := cur.Peek(); !ok || v != 1 {
+		t.Errorf("cur.Peek() = %d, %v, want 1, true", v, ok)
+	}
+	for v, ok := cur.Next(); ok; v, ok = cur.Next() {
+		cur.Set(v * 10)
+	}
+	if a[0] != 10 || a[2] != 30 {
+		t.Errorf("expected [10 20 30], got %v", a)
+	}
+	if cur.Index() != 3 {
+		t.Errorf("cur.Index() = %d, want 3", cur.Index())
+	}
+	if v, ok := cur.Prev(); !ok || v != 30 {
+		t.Errorf("cur.Prev() = %d, %v, want 30, true", v, ok)
+	}
+	if v, ok := cur.Seek(1); !ok || v != 20 {
+		t.Errorf("cur.Seek(1) = %d, %v, want 20, true", v, ok)
+	}
+	if _, ok := cur.Seek(5); ok || cur.Index() != 3 {
+		t.Errorf("cur.Seek(5) should move after the last item, index = %d", cur.Index())
+	}
+	if cur.Set(1) {
+		t.Errorf("cur.Set() = true, want false")
+	}
+}
+
+func TestListCursorRemove(t *testing.T) {
+	var list DLList[int]
+	for i := 1; i <= 6; i++ {
+		list.PushBack(i)
+	}
+	cur := list.Cursor()
+	for v, ok := cur.Next(); ok; v, ok = cur.Next() {
+		if v%2 == 1 {
+			cur.Remove()
+		}
+	}
+	got := list.Iter().Collect()
+	if got.Len() != 3 || got[0] != 2 || got[2] != 6 || list.Len() != 3 {
+		t.Errorf("expected [2 4 6], got %v", got)
+	}
+	if v, ok := list.Back(); !ok || v != 6 {
+		t.Errorf("list.Back() = %d, %v, want 6, true", v, ok)
+	}
+}
+
+func TestListCursorInsert(t *testing.T) {
+	var list DLList[int]
+	list.PushBack(2)
+	cur := list.Cursor()
+	if cur.InsertBefore(0) {
+		t.Errorf("cur.InsertBefore() = true before the first element")
+	}
+	cur.Next()
+	cur.InsertBefore(1)
+	cur.InsertAfter(3)
+	got := list.Iter().Collect()
+	for i, v := range []int{1, 2, 3} {
+		if got[i] != v {
+			t.Errorf("expected %d at %d, got %d", v, i, got[i])
+		}
+	}
+	if v, ok := cur.Prev(); !ok || v != 1 {
+		t.Errorf("cur.Prev() = %d, %v, want 1, true", v, ok)
+	}
+	if v, ok := cur.Seek(2); !ok || v != 3 {
+		t.Errorf("cur.Seek(2) = %d, %v, want 3, true", v, ok)
+	}
+	if _, ok := cur.Next(); ok {
+		t.Errorf("cur.Next() = true after the last element")
+	}
+	if v, ok := cur.Prev(); !ok || v != 3 {
+		t.Errorf("cur.Prev() = %d, %v, want 3, true", v, ok)
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/isgj/collection
 
-go 1.18
+go 1.23
 
-require golang.org/x/exp v0.0.0-20220321173239-a90fa8a75705 // indirect
+require golang.org/x/exp v0.0.0-20220321173239-a90fa8a75705
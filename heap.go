@@ -0,0 +1,170 @@
+package collection
+
+import "golang.org/x/exp/constraints"
+
+// Heap is a binary heap, it can be used as a priority queue.
+// The value at the top is the one that comes first according to the `less` function,
+// so with `a < b` it's a min-heap, with `a > b` it's a max-heap.
+//
+// Push and Pop are O(log n), Peek is O(1). The zero value is not usable, create a heap with `NewHeap`.
+type Heap[T any] struct {
+	items Vec[T]
+	less  func(a, b T) bool
+}
+
+// NewHeap creates an empty heap ordered by `less`.
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// NewMinHeap creates an empty heap where the smallest value is at the top.
+func NewMinHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool { return a < b })
+}
+
+// NewHeapFromVec creates a heap ordered by `less` with the values of the `Vec` in O(n) time.
+// The heap takes ownership of the `Vec`, it must not be used afterwards.
+func NewHeapFromVec[T any](vec Vec[T], less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{items: vec, less: less}
+	for i := len(vec)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return h
+}
+
+// Clear removes all values from the heap.
+func (h *Heap[T]) Clear() {
+	clear(h.items)
+	h.items = h.items[:0]
+}
+
+// IsEmpty returns true if the heap has no values.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Iter returns an iterator that pops the values from the heap, in priority order.
+// The values are removed from the heap as they are yielded.
+func (h *Heap[T]) Iter() Iterator[T] {
+	done := false
+	return func() (item T, ok bool) {
+		if !done {
+			item, ok = h.Pop()
+			done = !ok
+		}
+		return item, ok
+	}
+}
+
+// Len returns the number of values in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Peek returns the value at the top of the heap without removing it.
+// The second returned value is false if the heap is empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		return *new(T), false
+	}
+	return h.items[0], true
+}
+
+// Pop removes the value at the top of the heap and returns it.
+// The second returned value is false if the heap is empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		return *new(T), false
+	}
+	top, last := h.items[0], len(h.items)-1
+	h.items[0] = h.items[last]
+	h.items[last] = *new(T)
+	h.items = h.items[:last]
+	h.down(0)
+	return top, true
+}
+
+// Push adds the value to the heap.
+func (h *Heap[T]) Push(item T) {
+	h.items = append(h.items, item)
+	h.up(len(h.items) - 1)
+}
+
+// PushAll adds all the values to the heap.
+func (h *Heap[T]) PushAll(items ...T) {
+	for _, item := range items {
+		h.Push(item)
+	}
+}
+
+// Replace removes the value at the top of the heap and adds `item`, returning the removed value.
+// It's faster than `Pop` followed by `Push`. If the heap is empty, `item` is added and the second
+// returned value is false.
+func (h *Heap[T]) Replace(item T) (T, bool) {
+	if len(h.items) == 0 {
+		h.Push(item)
+		return *new(T), false
+	}
+	top := h.items[0]
+	h.items[0] = item
+	h.down(0)
+	return top, true
+}
+
+// SortedIter returns an iterator over the values in priority order, without removing them.
+// It takes O(log k) time for the k-th value, so it's cheap to look only at the first values.
+// The heap must not be modified while iterating.
+func (h *Heap[T]) SortedIter() Iterator[T] {
+	// the candidates are the indexes of the values not yielded yet whose parent was yielded
+	candidates := NewHeap(func(a, b int) bool { return h.less(h.items[a], h.items[b]) })
+	if len(h.items) > 0 {
+		candidates.Push(0)
+	}
+	return func() (T, bool) {
+		i, ok := candidates.Pop()
+		if !ok {
+			return *new(T), false
+		}
+		for _, child := range [2]int{2*i + 1, 2*i + 2} {
+			if child < len(h.items) {
+				candidates.Push(child)
+			}
+		}
+		return h.items[i], true
+	}
+}
+
+// ToVec returns a `Vec` with the values of the heap, in no particular order.
+func (h *Heap[T]) ToVec() Vec[T] {
+	return h.items.Clone()
+}
+
+// down moves the value at index i down until it comes before its children.
+func (h *Heap[T]) down(i int) {
+	for {
+		first := i
+		if l := 2*i + 1; l < len(h.items) && h.less(h.items[l], h.items[first]) {
+			first = l
+		}
+		if r := 2*i + 2; r < len(h.items) && h.less(h.items[r], h.items[first]) {
+			first = r
+		}
+		if first == i {
+			return
+		}
+		h.items[i], h.items[first] = h.items[first], h.items[i]
+		i = first
+	}
+}
+
+// up moves the value at index i up until it comes after its parent.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
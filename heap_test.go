@@ -0,0 +1,117 @@
+package collection
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestHeapPushPop(t *testing.T) {
+	h := NewMinHeap[int]()
+	if _, ok := h.Pop(); ok {
+		t.Errorf("h.Pop() on an empty heap should return false")
+	}
+	h.PushAll(5, 3, 8, 1, 9, 1)
+	h.Push(4)
+	if v, ok := h.Peek(); !ok || v != 1 {
+		t.Errorf("h.Peek() = %d, %t, want %d, %t", v, ok, 1, true)
+	}
+	if h.Len() != 7 {
+		t.Errorf("h.Len() = %d, want %d", h.Len(), 7)
+	}
+	for _, want := range []int{1, 1, 3, 4, 5, 8, 9} {
+		if v, ok := h.Pop(); !ok || v != want {
+			t.Errorf("h.Pop() = %d, %t, want %d, %t", v, ok, want, true)
+		}
+	}
+	if !h.IsEmpty() {
+		t.Errorf("h.IsEmpty() = %t, want %t", false, true)
+	}
+}
+
+func TestHeapWithLess(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	h := NewHeap(func(a, b task) bool { return a.priority > b.priority })
+	h.PushAll(task{"low", 1}, task{"high", 10}, task{"mid", 5})
+	if v, _ := h.Pop(); v.name != "high" {
+		t.Errorf("h.Pop() = %s, want %s", v.name, "high")
+	}
+	if v, _ := h.Peek(); v.name != "mid" {
+		t.Errorf("h.Peek() = %s, want %s", v.name, "mid")
+	}
+}
+
+func TestNewHeapFromVec(t *testing.T) {
+	vec := Vec[int]{}
+	for range 100 {
+		vec = append(vec, rand.Intn(50))
+	}
+	want := slices.Clone(vec)
+	slices.Sort(want)
+	h := NewHeapFromVec(vec, func(a, b int) bool { return a < b })
+	got := h.Iter().Collect()
+	if !slices.Equal(got, want) {
+		t.Errorf("h.Iter() = %v, want %v", got, want)
+	}
+	if !h.IsEmpty() {
+		t.Errorf("h.Iter() should drain the heap, %d values left", h.Len())
+	}
+}
+
+func TestHeapSortedIter(t *testing.T) {
+	h := NewMinHeap[int]()
+	h.PushAll(7, 2, 9, 4, 4, 1, 8)
+	got := h.SortedIter().Collect()
+	if want := []int{1, 2, 4, 4, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("h.SortedIter() = %v, want %v", got, want)
+	}
+	if h.Len() != 7 {
+		t.Errorf("h.Len() = %d, want %d", h.Len(), 7)
+	}
+	if got := h.SortedIter().Take(3).Collect(); !slices.Equal(got, []int{1, 2, 4}) {
+		t.Errorf("h.SortedIter().Take(3) = %v, want %v", got, []int{1, 2, 4})
+	}
+	if got := NewMinHeap[int]().SortedIter().Collect(); len(got) != 0 {
+		t.Errorf("empty heap SortedIter() = %v, want []", got)
+	}
+}
+
+func TestHeapIterEnds(t *testing.T) {
+	h := NewMinHeap[int]()
+	h.Push(1)
+	it := h.Iter()
+	it()
+	if _, ok := it(); ok {
+		t.Errorf("it() on a drained heap should return false")
+	}
+	h.Push(2)
+	if _, ok := it(); ok {
+		t.Errorf("it() should keep returning false after the end")
+	}
+	h.Clear()
+	if !h.IsEmpty() {
+		t.Errorf("h.IsEmpty() = %t, want %t", false, true)
+	}
+}
+
+func TestHeapReplace(t *testing.T) {
+	h := NewMinHeap[int]()
+	if _, ok := h.Replace(3); ok || h.Len() != 1 {
+		t.Errorf("h.Replace() on an empty heap should add the value and return false")
+	}
+	h.PushAll(1, 5)
+	if v, ok := h.Replace(4); !ok || v != 1 {
+		t.Errorf("h.Replace(4) = %d, %t, want %d, %t", v, ok, 1, true)
+	}
+	got := h.ToVec()
+	slices.Sort(got)
+	if !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("h.ToVec() = %v, want %v", got, []int{3, 4, 5})
+	}
+	if got := h.Iter().Collect(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("h.Iter() = %v, want %v", got, []int{3, 4, 5})
+	}
+}
@@ -0,0 +1,80 @@
+package iter
+
+import (
+	"errors"
+	"fmt"
+
+	c "github.com/isgj/collection"
+)
+
+// ErrDuplicateKey is returned by `ToMap` when two values have the same key and the policy is `ErrorOnConflict`.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// ConflictPolicy tells `ToMap` what to do when two values have the same key.
+type ConflictPolicy int
+
+const (
+	// KeepFirst keeps the value of the first key.
+	KeepFirst ConflictPolicy = iota
+	// KeepLast keeps the value of the last key.
+	KeepLast
+	// ErrorOnConflict stops at the first repeated key and returns `ErrDuplicateKey`.
+	ErrorOnConflict
+)
+
+// GroupBy will consume the iterator and group the values by the key returned by `key`.
+// The values of every group keep the iteration order.
+func GroupBy[T any, K comparable](it c.Iterator[T], key func(item T) K) c.Map[K, c.Vec[T]] {
+	groups := c.Map[K, c.Vec[T]]{}
+	for i, ok := it(); ok; i, ok = it() {
+		k := key(i)
+		groups[k] = append(groups[k], i)
+	}
+	return groups
+}
+
+// CountBy will consume the iterator and count the values by the key returned by `key`.
+func CountBy[T any, K comparable](it c.Iterator[T], key func(item T) K) c.Map[K, int] {
+	counts := c.Map[K, int]{}
+	for i, ok := it(); ok; i, ok = it() {
+		counts[key(i)]++
+	}
+	return counts
+}
+
+// ToMap will consume the iterator and collect the values to a map through the `key` and `val` functions.
+// When two values have the same key, `policy` decides which one is kept.
+// The error is not nil only with `ErrorOnConflict`, in that case the map collected until then is returned
+// and the error wraps `ErrDuplicateKey`.
+func ToMap[T any, K comparable, V any](it c.Iterator[T], key func(item T) K, val func(item T) V, policy ConflictPolicy) (c.Map[K, V], error) {
+	m := c.Map[K, V]{}
+	for i, ok := it(); ok; i, ok = it() {
+		k := key(i)
+		if m.Has(k) {
+			switch policy {
+			case KeepFirst:
+				continue
+			case ErrorOnConflict:
+				return m, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+			}
+		}
+		m[k] = val(i)
+	}
+	return m, nil
+}
+
+// MapValues will return a new map with the same keys and the values mapped through the `to` function.
+//
+// This is a workaround to implement `MapValues` as currently methods cannot have type parameters.
+func MapValues[K comparable, V any, O any](m c.Map[K, V], to func(val V) O) c.Map[K, O] {
+	result := make(c.Map[K, O], len(m))
+	for k, v := range m {
+		result[k] = to(v)
+	}
+	return result
+}
+
+// ToSet will consume the iterator and collect the values to a set. It's an alias to `collection.NewSetFromIter`.
+func ToSet[T comparable](it c.Iterator[T]) c.Set[T] {
+	return c.NewSetFromIter(it)
+}
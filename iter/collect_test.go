@@ -0,0 +1,54 @@
+package iter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	c "github.com/isgj/collection"
+)
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy(Range(7), func(i int) int { return i % 3 })
+	if groups.Len() != 3 || groups[0].Len() != 3 || groups[1][1] != 4 {
+		t.Errorf("expected map[0:[0 3 6] 1:[1 4] 2:[2 5]], got %v", groups)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	counts := CountBy(c.Vec[string]{"a", "bb", "cc", "d", "eee"}.Iter(), func(s string) int { return len(s) })
+	if counts[1] != 2 || counts[2] != 2 || counts[3] != 1 {
+		t.Errorf("expected map[1:2 2:2 3:1], got %v", counts)
+	}
+}
+
+func TestToMap(t *testing.T) {
+	words := c.Vec[string]{"apple", "avocado", "banana"}
+	first := func(s string) byte { return s[0] }
+	id := func(s string) string { return s }
+	m, err := ToMap(words.Iter(), first, id, KeepFirst)
+	if err != nil || m['a'] != "apple" {
+		t.Errorf("expected apple, nil, got %s, %v", m['a'], err)
+	}
+	m, err = ToMap(words.Iter(), first, id, KeepLast)
+	if err != nil || m['a'] != "avocado" {
+		t.Errorf("expected avocado, nil, got %s, %v", m['a'], err)
+	}
+	_, err = ToMap(words.Iter(), first, id, ErrorOnConflict)
+	if !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("expected %v, got %v", ErrDuplicateKey, err)
+	}
+}
+
+func TestToSet(t *testing.T) {
+	if s := ToSet(c.Vec[int]{1, 2, 2, 3}.Iter()); s.Len() != 3 {
+		t.Errorf("expected 3, got %d", s.Len())
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	m := MapValues(c.Map[string, int]{"a": 1, "b": 2}, func(v int) string { return fmt.Sprint(v * 2) })
+	if m["a"] != "2" || m["b"] != "4" {
+		t.Errorf("expected map[a:2 b:4], got %v", m)
+	}
+}
@@ -0,0 +1,86 @@
+package iter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+
+	c "github.com/isgj/collection"
+)
+
+// Lines returns an iterator over the lines of the reader, without the end-of-line marker.
+// The reader is read lazily, only as much as needed. Lines longer than `bufio.MaxScanTokenSize`
+// fail with `bufio.ErrTooLong`, use `Scan` with a larger `maxTokenSize` to read longer lines.
+//
+// Example:
+//
+//	f, _ := os.Open("file.txt")
+//	defer f.Close()
+//	lines, err := iter.Lines(f).Filter(func(l string) bool { return l != "" }).Collect()
+func Lines(r io.Reader) c.TryIterator[string] {
+	return Scan(r, bufio.ScanLines, bufio.MaxScanTokenSize)
+}
+
+// Words returns an iterator over the space-separated words of the reader.
+func Words(r io.Reader) c.TryIterator[string] {
+	return Scan(r, bufio.ScanWords, bufio.MaxScanTokenSize)
+}
+
+// Delimited returns an iterator over the records of the reader separated by `delim`.
+// The delimiter is not part of the records. The last record does not need to end with `delim`.
+func Delimited(r io.Reader, delim byte) c.TryIterator[string] {
+	return Scan(r, func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, delim); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}, bufio.MaxScanTokenSize)
+}
+
+// Scan returns an iterator over the tokens of the reader produced by the `split` function.
+// `maxTokenSize` is the size of the largest token that can be read, larger tokens fail with `bufio.ErrTooLong`.
+func Scan(r io.Reader, split bufio.SplitFunc, maxTokenSize int) c.TryIterator[string] {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(split)
+	scanner.Buffer(nil, maxTokenSize)
+	return func() (string, bool, error) {
+		if scanner.Scan() {
+			return scanner.Text(), true, nil
+		}
+		return "", false, scanner.Err()
+	}
+}
+
+// CSV returns an iterator over the records of the CSV encoded reader.
+// The reader is read with the default `csv.Reader` settings, use `CSVReader` to customize them.
+func CSV(r io.Reader) c.TryIterator[[]string] {
+	return CSVReader(csv.NewReader(r))
+}
+
+// CSVReader returns an iterator over the records read from `cr`.
+// If `cr.ReuseRecord` is set the yielded records are only valid until the next call.
+func CSVReader(cr *csv.Reader) c.TryIterator[[]string] {
+	var err error
+	return func() ([]string, bool, error) {
+		if err != nil {
+			if err == io.EOF {
+				return nil, false, nil
+			}
+			return nil, false, err
+		}
+		var record []string
+		if record, err = cr.Read(); err == io.EOF {
+			return nil, false, nil
+		} else if err != nil {
+			return nil, false, err
+		}
+		return record, true, nil
+	}
+}
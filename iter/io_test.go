@@ -0,0 +1,63 @@
+package iter
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	v, err := Lines(strings.NewReader("one\ntwo\r\n\nthree")).Collect()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	want := []string{"one", "two", "", "three"}
+	if v.Len() != len(want) {
+		t.Fatalf("expected %v, got %v", want, v)
+	}
+	for i, w := range want {
+		if v[i] != w {
+			t.Errorf("expected %q at %d, got %q", w, i, v[i])
+		}
+	}
+}
+
+func TestScanTooLong(t *testing.T) {
+	it := Scan(strings.NewReader("short\n"+strings.Repeat("x", 100)), bufio.ScanLines, 16)
+	v, err := it.Collect()
+	if !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("expected %v, got %v", bufio.ErrTooLong, err)
+	}
+	if v.Len() != 1 || v[0] != "short" {
+		t.Errorf("expected [short], got %v", v)
+	}
+}
+
+func TestWords(t *testing.T) {
+	c, err := Words(strings.NewReader("  a few\twords \n here ")).Count()
+	if err != nil || c != 4 {
+		t.Errorf("expected 4, nil, got %d, %v", c, err)
+	}
+}
+
+func TestDelimited(t *testing.T) {
+	v, err := Delimited(strings.NewReader("a;b;;c;"), ';').Collect()
+	if err != nil || v.Len() != 4 || v[2] != "" || v[3] != "c" {
+		t.Errorf("expected [a b  c], nil, got %q, %v", v, err)
+	}
+}
+
+func TestCSV(t *testing.T) {
+	it := CSV(strings.NewReader("a,b\n1,2\n3\n"))
+	v, err := it.Collect()
+	if v.Len() != 2 || v[1][1] != "2" {
+		t.Errorf("expected two records, got %v", v)
+	}
+	if err == nil {
+		t.Errorf("expected a wrong number of fields error")
+	}
+	if _, ok, err2 := it(); ok || err2 != err {
+		t.Errorf("expected the same error, got %v, %v", ok, err2)
+	}
+}
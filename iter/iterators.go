@@ -2,6 +2,9 @@
 package iter
 
 import (
+	"context"
+	goiter "iter"
+
 	c "github.com/isgj/collection"
 	"golang.org/x/exp/constraints"
 )
@@ -34,6 +37,49 @@ func Reduce[I any, O any](it c.Iterator[I], start O, reducer func(acc O, item I)
 	return start
 }
 
+// ReduceContext is the same as `Reduce` but it stops as soon as the context is done.
+// In that case the value reduced until then is returned together with the context error.
+func ReduceContext[I any, O any](ctx context.Context, it c.Iterator[I], start O, reducer func(acc O, item I) O) (O, error) {
+	return TryReduce(it.WithContext(ctx), start, func(acc O, item I) (O, error) {
+		return reducer(acc, item), nil
+	})
+}
+
+// TryMap will map values of type `I` to type `O` through the fallible `to` mapper function.
+// The iteration stops at the first error, either returned by `it` or by `to`.
+func TryMap[I any, O any](it c.TryIterator[I], to func(item I) (O, error)) c.TryIterator[O] {
+	var err error
+	return func() (O, bool, error) {
+		if err != nil {
+			return *new(O), false, err
+		}
+		var i I
+		var ok bool
+		if i, ok, err = it(); !ok {
+			return *new(O), false, err
+		}
+		var o O
+		if o, err = to(i); err != nil {
+			return *new(O), false, err
+		}
+		return o, true, nil
+	}
+}
+
+// TryReduce will reduce the values through the fallible reducer.
+// It stops at the first error and returns the accumulated value until then.
+func TryReduce[I any, O any](it c.TryIterator[I], start O, reducer func(acc O, item I) (O, error)) (O, error) {
+	i, ok, err := it()
+	for ; ok; i, ok, err = it() {
+		next, rerr := reducer(start, i)
+		if rerr != nil {
+			return start, rerr
+		}
+		start = next
+	}
+	return start, err
+}
+
 // Sum will return the sum of the values. If the iterated values are strings it will concatenate them
 func Sum[T constraints.Ordered](it c.Iterator[T]) T {
 	result, _ := it()
@@ -43,6 +89,44 @@ func Sum[T constraints.Ordered](it c.Iterator[T]) T {
 	return result
 }
 
+// FromChan returns an iterator over the values received from the channel.
+// The iterator blocks waiting for the next value and ends when the channel is closed.
+func FromChan[T any](ch <-chan T) c.Iterator[T] {
+	return func() (T, bool) {
+		v, ok := <-ch
+		return v, ok
+	}
+}
+
+// FromSeq returns an iterator over the values of a `iter.Seq`.
+//
+// The sequence is consumed through `iter.Pull`, so the returned `stop` function must be called
+// if the iterator is not consumed until the end, otherwise the resources of the sequence are not released.
+// When the iterator is exhausted `stop` is called automatically and calling it again is a no-op.
+func FromSeq[T any](seq goiter.Seq[T]) (it c.Iterator[T], stop func()) {
+	next, stop := goiter.Pull(seq)
+	return func() (T, bool) {
+		v, ok := next()
+		if !ok {
+			stop()
+		}
+		return v, ok
+	}, stop
+}
+
+// FromSeq2 returns an iterator over the pairs of a `iter.Seq2`.
+// As with `FromSeq`, the returned `stop` function must be called if the iterator is not consumed until the end.
+func FromSeq2[K any, V any](seq goiter.Seq2[K, V]) (it c.Iterator2[K, V], stop func()) {
+	next, stop := goiter.Pull2(seq)
+	return func() (K, V, bool) {
+		k, v, ok := next()
+		if !ok {
+			stop()
+		}
+		return k, v, ok
+	}, stop
+}
+
 func FromSlice[T any](s []T) c.Iterator[T] {
 	return c.Vec[T](s).Iter()
 }
@@ -65,3 +149,126 @@ func XRange(start, end, step int) c.Iterator[int] {
 		return 0, false
 	}
 }
+
+// Chunk will yield the values of the iterator in `Vec`s of `size` values, the last one can have less values.
+// Every chunk is a new `Vec`, it is safe to keep it after the next chunk is pulled.
+// If `size` is less than 1 it is set to 1.
+//
+// This is a workaround to implement `Chunk` as a method would instantiate `Iterator[Vec[T]]` recursively.
+func Chunk[T any](it c.Iterator[T], size int) c.Iterator[c.Vec[T]] {
+	if size < 1 {
+		size = 1
+	}
+	done := false
+	return func() (c.Vec[T], bool) {
+		if done {
+			return nil, false
+		}
+		chunk := make(c.Vec[T], 0, size)
+		for len(chunk) < size {
+			i, ok := it()
+			if !ok {
+				done = true
+				break
+			}
+			chunk = append(chunk, i)
+		}
+		if len(chunk) == 0 {
+			return nil, false
+		}
+		return chunk, true
+	}
+}
+
+// Window will yield sliding windows of `size` consecutive values, each window starts one value after the previous one.
+// If the iterator yields less than `size` values, no window is yielded.
+// Every window is a new `Vec`, it is safe to keep it after the next window is pulled.
+// If `size` is less than 1 it is set to 1.
+func Window[T any](it c.Iterator[T], size int) c.Iterator[c.Vec[T]] {
+	if size < 1 {
+		size = 1
+	}
+	var window c.Vec[T]
+	done := false
+	return func() (c.Vec[T], bool) {
+		if done {
+			return nil, false
+		}
+		if window == nil {
+			window = make(c.Vec[T], 0, size)
+			for len(window) < size {
+				i, ok := it()
+				if !ok {
+					done = true
+					return nil, false
+				}
+				window = append(window, i)
+			}
+			return window, true
+		}
+		i, ok := it()
+		if !ok {
+			done = true
+			return nil, false
+		}
+		next := make(c.Vec[T], size)
+		copy(next, window[1:])
+		next[size-1] = i
+		window = next
+		return window, true
+	}
+}
+
+// FlatMap will map every value to an iterator through `to` and yield the values of those iterators.
+func FlatMap[I any, O any](it c.Iterator[I], to func(item I) c.Iterator[O]) c.Iterator[O] {
+	return Flatten(Map(it, to))
+}
+
+// Flatten will yield the values of every iterator yielded by `it`, one iterator after the other.
+func Flatten[T any](it c.Iterator[c.Iterator[T]]) c.Iterator[T] {
+	var current c.Iterator[T]
+	return func() (T, bool) {
+		for {
+			if current != nil {
+				if i, ok := current(); ok {
+					return i, ok
+				}
+			}
+			next, ok := it()
+			if !ok {
+				current = nil
+				return *new(T), false
+			}
+			current = next
+		}
+	}
+}
+
+// Repeat will yield `value` endlessly, use `Take` to limit the number of values.
+func Repeat[T any](value T) c.Iterator[T] {
+	return func() (T, bool) {
+		return value, true
+	}
+}
+
+// Zip will yield the values of both the iterators in pairs.
+// The iteration ends as soon as one of the iterators ends, `b` is not advanced when `a` ends.
+func Zip[A any, B any](a c.Iterator[A], b c.Iterator[B]) c.Iterator2[A, B] {
+	done := false
+	return func() (A, B, bool) {
+		if done {
+			return *new(A), *new(B), false
+		}
+		i, ok_a := a()
+		if !ok_a {
+			done = true
+			return *new(A), *new(B), false
+		}
+		j, ok_b := b()
+		if !ok_b {
+			done = true
+			return *new(A), *new(B), false
+		}
+		return i, j, true
+	}
+}
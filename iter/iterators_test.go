@@ -0,0 +1,151 @@
+package iter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	c "github.com/isgj/collection"
+)
+
+func TestFromSeq(t *testing.T) {
+	v := c.Vec[int]{1, 2, 3}
+	it, stop := FromSeq(v.Seq())
+	defer stop()
+	got := it.Collect()
+	if got.Len() != 3 || got[2] != 3 {
+		t.Errorf("expected [1 2 3], got %v", got)
+	}
+	if _, ok := it(); ok {
+		t.Errorf("expected !ok after exhaustion")
+	}
+}
+
+func TestFromSeqStop(t *testing.T) {
+	it, stop := FromSeq(Range(10).Seq())
+	if v, ok := it(); !ok || v != 0 {
+		t.Errorf("expected 0, true, got %d, %v", v, ok)
+	}
+	stop()
+	if _, ok := it(); ok {
+		t.Errorf("expected !ok after stop")
+	}
+}
+
+func TestFromSeq2(t *testing.T) {
+	m := c.Map[string, int]{"a": 1, "b": 2}
+	it, stop := FromSeq2(m.All())
+	defer stop()
+	got := c.NewMapFromIter(it)
+	if got.Len() != 2 || got["a"] != 1 || got["b"] != 2 {
+		t.Errorf("expected map[a:1 b:2], got %v", got)
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	fail := errors.New("fail")
+	it := TryMap(Range(5).Try(), func(i int) (int, error) {
+		if i == 3 {
+			return 0, fail
+		}
+		return i * 2, nil
+	})
+	v, err := it.Collect()
+	if err != fail || v.Len() != 3 || v[2] != 4 {
+		t.Errorf("expected [0 2 4], %v, got %v, %v", fail, v, err)
+	}
+	if _, ok, err := it(); ok || err != fail {
+		t.Errorf("expected false, %v, got %v, %v", fail, ok, err)
+	}
+}
+
+func TestTryReduce(t *testing.T) {
+	sum, err := TryReduce(Range(4).Try(), 0, func(acc, i int) (int, error) { return acc + i, nil })
+	if err != nil || sum != 6 {
+		t.Errorf("expected 6, nil, got %d, %v", sum, err)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	if s := Sum(FromChan(ch)); s != 6 {
+		t.Errorf("expected 6, got %d", s)
+	}
+}
+
+func TestChanRoundTrip(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	set := c.NewSetFromIter(FromChan(Range(5).ToChan(ctx, 2)))
+	if set.Len() != 5 {
+		t.Errorf("expected 5, got %d", set.Len())
+	}
+}
+
+func TestReduceContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := ReduceContext(ctx, XRange(0, 1, 0), 0, func(acc, i int) int { return acc + i })
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	chunks := Chunk(Range(7), 3).Collect()
+	if chunks.Len() != 3 || chunks[0].Len() != 3 || chunks[2].Len() != 1 || chunks[2][0] != 6 {
+		t.Errorf("expected [[0 1 2] [3 4 5] [6]], got %v", chunks)
+	}
+	if Chunk(Range(0), 3).Count() != 0 {
+		t.Errorf("expected no chunks")
+	}
+}
+
+func TestWindow(t *testing.T) {
+	windows := Window(Range(5), 3).Collect()
+	if windows.Len() != 3 {
+		t.Fatalf("expected 3 windows, got %v", windows)
+	}
+	for i, w := range windows {
+		if w.Len() != 3 || w[0] != i || w[2] != i+2 {
+			t.Errorf("expected window starting at %d, got %v", i, w)
+		}
+	}
+	if Window(Range(2), 3).Count() != 0 {
+		t.Errorf("expected no windows")
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	v := FlatMap(Range(4), func(i int) c.Iterator[int] { return Range(i) }).Collect()
+	if v.Len() != 6 {
+		t.Errorf("expected [0 0 1 0 1 2], got %v", v)
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	if s := Sum(Repeat(2).Take(5)); s != 10 {
+		t.Errorf("expected 10, got %d", s)
+	}
+}
+
+func TestZip(t *testing.T) {
+	words := c.Vec[string]{"a", "b", "c"}
+	m := c.NewMapFromIter(Zip(words.Iter(), Range(10)))
+	if m.Len() != 3 || m["c"] != 2 {
+		t.Errorf("expected map[a:0 b:1 c:2], got %v", m)
+	}
+}
+
+func TestZipKeepsPosition(t *testing.T) {
+	numbers := Range(10)
+	Zip(c.Vec[string]{"a", "b", "c"}.Iter(), numbers).Count()
+	if next, ok := numbers(); !ok || next != 3 {
+		t.Errorf("expected 3, true, got %d, %v", next, ok)
+	}
+}
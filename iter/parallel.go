@@ -0,0 +1,195 @@
+package iter
+
+import (
+	"context"
+	"sync"
+
+	c "github.com/isgj/collection"
+)
+
+// ParMap is the parallel version of `Map`, the `to` mapper function runs in `workers` goroutines.
+//
+// When `ordered` is true the values are yielded in the same order as the input, otherwise
+// they are yielded as soon as they are mapped. At most `2*workers` values are in flight at a time,
+// so a slow value will not make the memory grow when `ordered` is true.
+//
+// The input iterator is consumed in a separate goroutine, it must not be used by other goroutines.
+// The returned iterator ends when the input is exhausted or when the context is done.
+// Cancel the context if the returned iterator is not consumed until the end, otherwise the goroutines are leaked.
+// If `to` or the input iterator panics, the panic is propagated to the goroutine that consumes the returned iterator.
+func ParMap[I any, O any](ctx context.Context, it c.Iterator[I], workers int, ordered bool, to func(item I) O) c.Iterator[O] {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	slots := make(chan struct{}, 2*workers)
+	jobs := make(chan parItem[I])
+	results := make(chan parItem[O], workers)
+
+	var wg sync.WaitGroup
+	wg.Add(workers + 1)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		// a panic of the input iterator is sent to the consumer like the panics of `to`
+		defer func() {
+			if p := recover(); p != nil {
+				select {
+				case results <- parItem[O]{panicked: true, panic: p}:
+				case <-ctx.Done():
+				}
+			}
+		}()
+		index := 0
+		for i, ok := it(); ok; i, ok = it() {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- parItem[I]{index: index, value: i}:
+				index++
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				res := parMapOne(job, to)
+				select {
+				case results <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	pending := map[int]parItem[O]{}
+	next := 0
+	done := false
+	return func() (O, bool) {
+		for !done {
+			if ordered {
+				if res, ok := pending[next]; ok {
+					delete(pending, next)
+					next++
+					<-slots
+					return res.value, true
+				}
+			}
+			res, ok := <-results
+			if !ok || ctx.Err() != nil {
+				done = true
+				cancel()
+				break
+			}
+			if res.panicked {
+				done = true
+				cancel()
+				panic(res.panic)
+			}
+			if !ordered {
+				<-slots
+				return res.value, true
+			}
+			pending[res.index] = res
+		}
+		return *new(O), false
+	}
+}
+
+// ParFilter is the parallel version of `Iterator.Filter`, the test runs in `workers` goroutines.
+// It follows the same rules of `ParMap`.
+func ParFilter[T any](ctx context.Context, it c.Iterator[T], workers int, ordered bool, test func(item T) bool) c.Iterator[T] {
+	tested := ParMap(ctx, it, workers, ordered, func(item T) parTested[T] {
+		return parTested[T]{value: item, passed: test(item)}
+	})
+	return Map(tested.Filter(func(item parTested[T]) bool { return item.passed }), func(item parTested[T]) T {
+		return item.value
+	})
+}
+
+// ParForEach is the parallel version of `Iterator.ForEach`, the action runs in `workers` goroutines.
+// The input iterator is consumed in the calling goroutine. ParForEach returns when all the values are processed,
+// or when the context is done, in which case it waits for the values already sent to the workers
+// and returns the context error.
+// If `action` or the input iterator panics, the panic is propagated to the calling goroutine.
+func ParForEach[T any](ctx context.Context, it c.Iterator[T], workers int, action func(item T)) error {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobs := make(chan T)
+	var wg sync.WaitGroup
+	var panicked sync.Once
+	var panicValue any
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				if res := parMapOne(parItem[T]{value: job}, func(item T) struct{} {
+					action(item)
+					return struct{}{}
+				}); res.panicked {
+					panicked.Do(func() { panicValue = res.panic })
+					cancel()
+				}
+			}
+		}()
+	}
+	// the workers are stopped also if the input iterator panics
+	defer func() {
+		close(jobs)
+		wg.Wait()
+		if panicValue != nil {
+			panic(panicValue)
+		}
+	}()
+	for i, ok := it(); ok; i, ok = it() {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// parItem carries a value through the parallel pipeline together with its input position.
+// When used as a result, `panicked` reports that the mapper panicked with the value `panic`.
+type parItem[T any] struct {
+	index    int
+	value    T
+	panicked bool
+	panic    any
+}
+
+func parMapOne[I any, O any](job parItem[I], to func(item I) O) (res parItem[O]) {
+	res.index = job.index
+	defer func() {
+		if p := recover(); p != nil {
+			res.panicked = true
+			res.panic = p
+		}
+	}()
+	res.value = to(job.value)
+	return res
+}
+
+// parTested is the result of the test of `ParFilter`.
+type parTested[T any] struct {
+	value  T
+	passed bool
+}
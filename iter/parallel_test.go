@@ -0,0 +1,131 @@
+package iter
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParMapOrdered(t *testing.T) {
+	got := ParMap(context.Background(), Range(100), 4, true, func(i int) int {
+		if i%7 == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		return i * 2
+	}).Collect()
+	if got.Len() != 100 {
+		t.Fatalf("expected 100 values, got %d", got.Len())
+	}
+	for i, v := range got {
+		if v != i*2 {
+			t.Errorf("expected %d at %d, got %d", i*2, i, v)
+		}
+	}
+}
+
+func TestParMapUnordered(t *testing.T) {
+	if s := Sum(ParMap(context.Background(), Range(100), 8, false, func(i int) int { return i })); s != 4950 {
+		t.Errorf("expected 4950, got %d", s)
+	}
+}
+
+func TestParMapCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	infinite := XRange(0, 1, 0)
+	it := ParMap(ctx, infinite, 4, true, func(i int) int { return i })
+	it.Take(10).Count()
+	cancel()
+	// the iterator must end after the cancellation
+	it.Count()
+}
+
+func TestParMapPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p != "boom" {
+			t.Errorf("expected panic boom, got %v", p)
+		}
+	}()
+	ParMap(context.Background(), Range(10), 2, false, func(i int) int {
+		if i == 5 {
+			panic("boom")
+		}
+		return i
+	}).Count()
+	t.Errorf("expected a panic")
+}
+
+func TestParFilter(t *testing.T) {
+	got := ParFilter(context.Background(), Range(10), 3, true, func(i int) bool { return i%2 == 0 }).Collect()
+	if got.Len() != 5 || got[4] != 8 {
+		t.Errorf("expected [0 2 4 6 8], got %v", got)
+	}
+}
+
+func TestParForEach(t *testing.T) {
+	var sum int64
+	err := ParForEach(context.Background(), Range(10), 3, func(i int) { atomic.AddInt64(&sum, int64(i)) })
+	if err != nil || sum != 45 {
+		t.Errorf("expected 45, nil, got %d, %v", sum, err)
+	}
+}
+
+func TestParMapInputPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p != "input" {
+			t.Errorf("expected panic input, got %v", p)
+		}
+	}()
+	input := Range(10).Tap(func(i int) {
+		if i == 5 {
+			panic("input")
+		}
+	})
+	ParMap(context.Background(), input, 2, true, func(i int) int { return i }).Count()
+	t.Errorf("expected a panic")
+}
+
+func TestParForEachPanic(t *testing.T) {
+	defer func() {
+		if p := recover(); p != "boom" {
+			t.Errorf("expected panic boom, got %v", p)
+		}
+	}()
+	ParForEach(context.Background(), Range(100), 4, func(i int) {
+		if i == 5 {
+			panic("boom")
+		}
+	})
+	t.Errorf("expected a panic")
+}
+
+func TestParForEachCancelAfterLast(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var sum int64
+	values := Range(10)
+	input := func() (int, bool) {
+		i, ok := values()
+		if !ok {
+			cancel()
+		}
+		return i, ok
+	}
+	// the context is done when the input ends, all the values were already sent to the workers
+	err := ParForEach(ctx, input, 3, func(i int) { atomic.AddInt64(&sum, int64(i)) })
+	if err != nil || sum != 45 {
+		t.Errorf("expected 45, nil, got %d, %v", sum, err)
+	}
+}
+
+func TestParForEachCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var count int64
+	err := ParForEach(ctx, XRange(0, 1, 0), 2, func(i int) {
+		if atomic.AddInt64(&count, 1) == 10 {
+			cancel()
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,123 @@
+package iter
+
+import (
+	"cmp"
+	"slices"
+
+	c "github.com/isgj/collection"
+	"golang.org/x/exp/constraints"
+)
+
+// Min will return the smallest value of the iterator.
+// The second returned value is false if the iterator yields no values.
+func Min[T constraints.Ordered](it c.Iterator[T]) (T, bool) {
+	return MinBy(it, func(item T) T { return item })
+}
+
+// Max will return the largest value of the iterator.
+// The second returned value is false if the iterator yields no values.
+func Max[T constraints.Ordered](it c.Iterator[T]) (T, bool) {
+	return MaxBy(it, func(item T) T { return item })
+}
+
+// MinBy will return the value with the smallest key, if several values have the same key the first one is returned.
+// The second returned value is false if the iterator yields no values.
+func MinBy[T any, K constraints.Ordered](it c.Iterator[T], key func(item T) K) (T, bool) {
+	result, ok := it()
+	if !ok {
+		return result, false
+	}
+	smallest := key(result)
+	for i, ok := it(); ok; i, ok = it() {
+		if k := key(i); k < smallest {
+			result, smallest = i, k
+		}
+	}
+	return result, true
+}
+
+// MaxBy will return the value with the largest key, if several values have the same key the first one is returned.
+// The second returned value is false if the iterator yields no values.
+func MaxBy[T any, K constraints.Ordered](it c.Iterator[T], key func(item T) K) (T, bool) {
+	result, ok := it()
+	if !ok {
+		return result, false
+	}
+	largest := key(result)
+	for i, ok := it(); ok; i, ok = it() {
+		if k := key(i); k > largest {
+			result, largest = i, k
+		}
+	}
+	return result, true
+}
+
+// Sorted will consume the iterator and return a `Vec` with the values in increasing order.
+func Sorted[T constraints.Ordered](it c.Iterator[T]) c.Vec[T] {
+	vec := it.Collect()
+	slices.SortStableFunc(vec, cmp.Compare[T])
+	return vec
+}
+
+// SortedBy will consume the iterator and return a `Vec` with the values sorted by `less`.
+// The sort is stable, values that are equal keep the iteration order.
+func SortedBy[T any](it c.Iterator[T], less func(a, b T) bool) c.Vec[T] {
+	vec := it.Collect()
+	vec.SortStable(less)
+	return vec
+}
+
+// IsSorted checks if the values of the iterator are in increasing order.
+// It stops as soon as a value is smaller than the previous one.
+func IsSorted[T constraints.Ordered](it c.Iterator[T]) bool {
+	return IsSortedBy(it, func(a, b T) bool { return a < b })
+}
+
+// IsSortedBy checks if the values of the iterator are sorted by `less`.
+// It stops as soon as a value is less than the previous one.
+func IsSortedBy[T any](it c.Iterator[T], less func(a, b T) bool) bool {
+	prev, ok := it()
+	if !ok {
+		return true
+	}
+	for i, ok := it(); ok; i, ok = it() {
+		if less(i, prev) {
+			return false
+		}
+		prev = i
+	}
+	return true
+}
+
+// Largest will return the `n` largest values of the iterator, from the largest to the smallest.
+// Only `n` values are kept in memory while consuming the iterator, which takes O(len*log(n)) time.
+func Largest[T constraints.Ordered](it c.Iterator[T], n int) c.Vec[T] {
+	return topN(it, n, func(a, b T) bool { return a > b })
+}
+
+// Smallest will return the `n` smallest values of the iterator, from the smallest to the largest.
+// Only `n` values are kept in memory while consuming the iterator, which takes O(len*log(n)) time.
+func Smallest[T constraints.Ordered](it c.Iterator[T], n int) c.Vec[T] {
+	return topN(it, n, func(a, b T) bool { return a < b })
+}
+
+// topN keeps the `n` values that come first according to `before` in a heap
+// where the top is the value that comes last, so it's the one replaced by a better value.
+func topN[T any](it c.Iterator[T], n int, before func(a, b T) bool) c.Vec[T] {
+	if n <= 0 {
+		return c.Vec[T]{}
+	}
+	kept := c.NewHeapFromVec(make(c.Vec[T], 0, n), func(a, b T) bool { return before(b, a) })
+	for v, ok := it(); ok; v, ok = it() {
+		if kept.Len() < n {
+			kept.Push(v)
+			continue
+		}
+		if last, _ := kept.Peek(); before(v, last) {
+			kept.Replace(v)
+		}
+	}
+	result := kept.ToVec()
+	result.SortStable(before)
+	return result
+}
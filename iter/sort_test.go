@@ -0,0 +1,66 @@
+package iter
+
+import (
+	"testing"
+
+	c "github.com/isgj/collection"
+)
+
+func TestMinMax(t *testing.T) {
+	v := c.Vec[int]{3, 1, 4, 1, 5, 9, 2, 6}
+	if m, ok := Min(v.Iter()); !ok || m != 1 {
+		t.Errorf("expected 1, true, got %d, %v", m, ok)
+	}
+	if m, ok := Max(v.Iter()); !ok || m != 9 {
+		t.Errorf("expected 9, true, got %d, %v", m, ok)
+	}
+	if _, ok := Min(Range(0)); ok {
+		t.Errorf("expected false, got true")
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	words := c.Vec[string]{"bb", "a", "ccc", "d", "eee"}
+	length := func(s string) int { return len(s) }
+	if w, _ := MinBy(words.Iter(), length); w != "a" {
+		t.Errorf("expected a, got %s", w)
+	}
+	if w, _ := MaxBy(words.Iter(), length); w != "ccc" {
+		t.Errorf("expected ccc, got %s", w)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	v := Sorted(c.Vec[int]{3, 1, 2}.Iter())
+	if !IsSorted(v.Iter()) || v.Len() != 3 {
+		t.Errorf("expected [1 2 3], got %v", v)
+	}
+	words := SortedBy(c.Vec[string]{"bb", "a", "cc", "d"}.Iter(), func(a, b string) bool { return len(a) < len(b) })
+	for i, w := range []string{"a", "d", "bb", "cc"} {
+		if words[i] != w {
+			t.Errorf("expected %s at %d, got %s", w, i, words[i])
+		}
+	}
+	if IsSorted(c.Vec[int]{1, 3, 2}.Iter()) {
+		t.Errorf("expected false, got true")
+	}
+}
+
+func TestLargestSmallest(t *testing.T) {
+	v := c.Vec[int]{3, 1, 4, 1, 5, 9, 2, 6}
+	largest := Largest(v.Iter(), 3)
+	for i, w := range []int{9, 6, 5} {
+		if largest[i] != w {
+			t.Errorf("expected %d at %d, got %d", w, i, largest[i])
+		}
+	}
+	smallest := Smallest(v.Iter(), 3)
+	for i, w := range []int{1, 1, 2} {
+		if smallest[i] != w {
+			t.Errorf("expected %d at %d, got %d", w, i, smallest[i])
+		}
+	}
+	if Smallest(v.Iter(), 20).Len() != v.Len() {
+		t.Errorf("expected all the values")
+	}
+}
@@ -1,5 +1,10 @@
 package collection
 
+import (
+	"context"
+	"iter"
+)
+
 // Iterator is a lazy iterator over generic data types.
 // It can be called several times to produce values.
 // When the second returned value is `true` means the value is valid and it can be consumed.
@@ -9,6 +14,7 @@ package collection
 //
 // Iterator is not limited to the structures defined in this package. The source of the iterated values can be anything.
 // Ex: think of iterating over the lines of a file without having to load the whole file into memory, or iterating over the cursor of a database.
+// For sources that can fail use `TryIterator`, the `iter` package has constructors for `io.Reader` sources (Ex: `iter.Lines`).
 type Iterator[T any] func() (T, bool)
 
 // Any returns true as soon as a value satisfies the test, false otherwise.
@@ -30,6 +36,12 @@ func (it Iterator[T]) Collect() Vec[T] {
 	return vec
 }
 
+// CollectContext is the same as `Collect` but it stops as soon as the context is done.
+// In that case the values collected until then are returned together with the context error.
+func (it Iterator[T]) CollectContext(ctx context.Context) (Vec[T], error) {
+	return it.WithContext(ctx).Collect()
+}
+
 // Count will consume the iterator and return the number of values iterated.
 func (it Iterator[T]) Count() int {
 	var c int
@@ -39,6 +51,48 @@ func (it Iterator[T]) Count() int {
 	return c
 }
 
+// CountContext is the same as `Count` but it stops as soon as the context is done.
+// In that case the number of values iterated until then is returned together with the context error.
+func (it Iterator[T]) CountContext(ctx context.Context) (int, error) {
+	return it.WithContext(ctx).Count()
+}
+
+// Cycle will yield the values of the iterator and then start again from the first one, endlessly.
+// The values are stored the first time they are pulled, so the iterator is consumed only once.
+// If the iterator yields no values, the cycle yields no values either.
+func (it Iterator[T]) Cycle() Iterator[T] {
+	var seen Vec[T]
+	exhausted := false
+	current := 0
+	return func() (T, bool) {
+		if !exhausted {
+			if i, ok := it(); ok {
+				seen = append(seen, i)
+				return i, ok
+			}
+			exhausted = true
+		}
+		if len(seen) == 0 {
+			return *new(T), false
+		}
+		i := seen[current%len(seen)]
+		current++
+		return i, true
+	}
+}
+
+// Enumerate will yield the values together with their position, starting from 0.
+func (it Iterator[T]) Enumerate() Iterator2[int, T] {
+	index := 0
+	return func() (int, T, bool) {
+		if i, ok := it(); ok {
+			index++
+			return index - 1, i, ok
+		}
+		return 0, *new(T), false
+	}
+}
+
 // Every will return false as soon as a value will fail the test, true otherwise.
 func (it Iterator[T]) Every(test func(item T) bool) bool {
 	for i, ok := it(); ok; i, ok = it() {
@@ -94,6 +148,50 @@ func (it Iterator[T]) ForEach(action func(item T)) {
 	}
 }
 
+// ForEachContext is the same as `ForEach` but it stops as soon as the context is done,
+// in which case the context error is returned.
+func (it Iterator[T]) ForEachContext(ctx context.Context, action func(item T)) error {
+	return it.WithContext(ctx).ForEach(func(item T) error {
+		action(item)
+		return nil
+	})
+}
+
+// Intersperse will yield `separator` between every two values of the iterator.
+func (it Iterator[T]) Intersperse(separator T) Iterator[T] {
+	var next T
+	has_next, started := false, false
+	return func() (T, bool) {
+		if !started {
+			started = true
+			return it()
+		}
+		if has_next {
+			has_next = false
+			return next, true
+		}
+		if i, ok := it(); ok {
+			next, has_next = i, true
+			return separator, true
+		}
+		return *new(T), false
+	}
+}
+
+// Partition will consume the iterator and split the values in two `Vec`s,
+// the first with the values that satisfy the test and the second with the others.
+func (it Iterator[T]) Partition(test func(item T) bool) (Vec[T], Vec[T]) {
+	var passed, failed Vec[T]
+	for i, ok := it(); ok; i, ok = it() {
+		if test(i) {
+			passed = append(passed, i)
+		} else {
+			failed = append(failed, i)
+		}
+	}
+	return passed, failed
+}
+
 // Skip will skip the first `count` values
 func (it Iterator[T]) Skip(count int) Iterator[T] {
 	skipped := false
@@ -124,6 +222,27 @@ func (it Iterator[T]) SkipWhile(test func(item T) bool) Iterator[T] {
 	}
 }
 
+// StepBy will yield the first value and then every `step` values.
+// If `step` is less than 1 it is set to 1.
+func (it Iterator[T]) StepBy(step int) Iterator[T] {
+	if step < 1 {
+		step = 1
+	}
+	started := false
+	return func() (T, bool) {
+		if !started {
+			started = true
+			return it()
+		}
+		for i := 1; i < step; i++ {
+			if _, ok := it(); !ok {
+				return *new(T), false
+			}
+		}
+		return it()
+	}
+}
+
 // Take will yield at most the first `count` values.
 func (it Iterator[T]) Take(count int) Iterator[T] {
 	taken := 0
@@ -166,8 +285,64 @@ func (it Iterator[T]) Tap(action func(item T)) Iterator[T] {
 	}
 }
 
+// ToChan will consume the iterator in a new goroutine and send the values to the returned channel.
+// The channel has a buffer of size `buffer` and it is closed when the iterator is exhausted or the context is done.
+// The goroutine exits as soon as the context is done, so cancel the context if the channel is not
+// drained until the end, otherwise the goroutine is leaked.
+// The iterator must not be used by other goroutines after calling `ToChan`.
+func (it Iterator[T]) ToChan(ctx context.Context, buffer int) <-chan T {
+	ch := make(chan T, buffer)
+	go func() {
+		defer close(ch)
+		for i, ok := it(); ok; i, ok = it() {
+			select {
+			case ch <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// Try returns the iterator as a `TryIterator` that never fails.
+func (it Iterator[T]) Try() TryIterator[T] {
+	return func() (T, bool, error) {
+		i, ok := it()
+		return i, ok, nil
+	}
+}
+
+// WithContext returns a `TryIterator` that ends as soon as the context is done.
+// The context is checked before pulling every value, once it is done the iterator fails with the context error.
+// It can be used to stop long running pipelines over large or infinite iterators:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	vec, err := iter.XRange(0, math.MaxInt, 1).Filter(isPrime).WithContext(ctx).Collect()
+func (it Iterator[T]) WithContext(ctx context.Context) TryIterator[T] {
+	return it.Try().WithContext(ctx)
+}
+
 // Reverse will consume the iterator, collect the values in a `Vec` and iterate in reverse those values.
 // Since `Reverse` will consume the iterator and allocate a `Vec`, when possible use `Vec.ReverseIter`.
 func (it Iterator[T]) Reverse() Iterator[T] {
 	return it.Collect().ReverseIter()
 }
+
+// Seq returns the iterator as a `iter.Seq`, so it can be used with the `range` keyword.
+//
+//	for v := range vec.Iter().Filter(isEven).Seq() {
+//		fmt.Println(v)
+//	}
+//
+// Breaking out of the loop stops consuming the iterator, the remaining values are not produced.
+func (it Iterator[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i, ok := it(); ok; i, ok = it() {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package collection
+
+import "iter"
+
+// Iterator2 is a lazy iterator over pairs of generic data types, usually key/value pairs.
+// It follows the same rules of `Iterator`: when the third returned value is `false` the pair is not valid,
+// the zero values are returned and consecutive calls should return the same values.
+type Iterator2[K any, V any] func() (K, V, bool)
+
+// Any returns true as soon as a pair satisfies the test, false otherwise.
+func (it Iterator2[K, V]) Any(test func(key K, val V) bool) bool {
+	for k, v, ok := it(); ok; k, v, ok = it() {
+		if test(k, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Count will consume the iterator and return the number of pairs iterated.
+func (it Iterator2[K, V]) Count() int {
+	var c int
+	for _, _, ok := it(); ok; _, _, ok = it() {
+		c++
+	}
+	return c
+}
+
+// Every will return false as soon as a pair will fail the test, true otherwise.
+func (it Iterator2[K, V]) Every(test func(key K, val V) bool) bool {
+	for k, v, ok := it(); ok; k, v, ok = it() {
+		if !test(k, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter will pass only the pairs that satisfy the test.
+func (it Iterator2[K, V]) Filter(test func(key K, val V) bool) Iterator2[K, V] {
+	return func() (K, V, bool) {
+		for k, v, ok := it(); ok; k, v, ok = it() {
+			if test(k, v) {
+				return k, v, ok
+			}
+		}
+		return *new(K), *new(V), false
+	}
+}
+
+// Find will try to find a pair that satisfies the test.
+// The third returned value is true if a pair was found, false otherwise.
+func (it Iterator2[K, V]) Find(test func(key K, val V) bool) (K, V, bool) {
+	for k, v, ok := it(); ok; k, v, ok = it() {
+		if test(k, v) {
+			return k, v, ok
+		}
+	}
+	return *new(K), *new(V), false
+}
+
+// ForEach will consume the iterator and run the action with every pair.
+func (it Iterator2[K, V]) ForEach(action func(key K, val V)) {
+	for k, v, ok := it(); ok; k, v, ok = it() {
+		action(k, v)
+	}
+}
+
+// Keys returns an iterator over the first values of the pairs.
+func (it Iterator2[K, V]) Keys() Iterator[K] {
+	return func() (K, bool) {
+		k, _, ok := it()
+		return k, ok
+	}
+}
+
+// Seq2 returns the iterator as a `iter.Seq2`, so it can be used with the `range` keyword.
+func (it Iterator2[K, V]) Seq2() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v, ok := it(); ok; k, v, ok = it() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Skip will skip the first `count` pairs.
+func (it Iterator2[K, V]) Skip(count int) Iterator2[K, V] {
+	skipped := false
+	return func() (K, V, bool) {
+		if skipped {
+			return it()
+		}
+		for i := 0; i < count; i++ {
+			if _, _, ok := it(); !ok {
+				skipped = true
+				return *new(K), *new(V), false
+			}
+		}
+		skipped = true
+		return it()
+	}
+}
+
+// Take will yield at most the first `count` pairs.
+func (it Iterator2[K, V]) Take(count int) Iterator2[K, V] {
+	taken := 0
+	return func() (K, V, bool) {
+		if taken >= count {
+			return *new(K), *new(V), false
+		}
+		if k, v, ok := it(); ok {
+			taken++
+			return k, v, ok
+		}
+		taken = count
+		return *new(K), *new(V), false
+	}
+}
+
+// Tap will run `action` with every pair that will pass through the iterator.
+func (it Iterator2[K, V]) Tap(action func(key K, val V)) Iterator2[K, V] {
+	return func() (K, V, bool) {
+		k, v, ok := it()
+		if ok {
+			action(k, v)
+		}
+		return k, v, ok
+	}
+}
+
+// Values returns an iterator over the second values of the pairs.
+func (it Iterator2[K, V]) Values() Iterator[V] {
+	return func() (V, bool) {
+		_, v, ok := it()
+		return v, ok
+	}
+}
+
+// NewMapFromIter will collect all the pairs of the iterator to a map.
+// If a key is repeated the last value is kept.
+func NewMapFromIter[K comparable, V any](it Iterator2[K, V]) Map[K, V] {
+	m := Map[K, V]{}
+	for k, v, ok := it(); ok; k, v, ok = it() {
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,60 @@
+package collection
+
+import "testing"
+
+func TestIterator2Filter(t *testing.T) {
+	a := Vec[int]{1, 2, 3, 4}
+	m := NewMapFromIter(a.Enumerate().Filter(func(i, v int) bool { return v%2 == 0 }))
+	if m.Len() != 2 || m[1] != 2 || m[3] != 4 {
+		t.Errorf("expected map[1:2 3:4], got %v", m)
+	}
+}
+
+func TestIterator2SkipTake(t *testing.T) {
+	a := Vec[int]{1, 2, 3, 4}
+	got := a.Enumerate().Skip(1).Take(2).Keys().Collect()
+	if got.Len() != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+}
+
+func TestIterator2Find(t *testing.T) {
+	m := Map[string, int]{"a": 1, "b": 2}
+	k, v, ok := m.Iter().Find(func(k string, v int) bool { return v == 2 })
+	if !ok || k != "b" || v != 2 {
+		t.Errorf("expected b, 2, true, got %s, %d, %v", k, v, ok)
+	}
+	if _, _, ok := m.Iter().Find(func(k string, v int) bool { return v == 3 }); ok {
+		t.Errorf("expected false, got true")
+	}
+}
+
+func TestMapIterSkipsDeleted(t *testing.T) {
+	m := Map[int, int]{1: 1, 2: 2, 3: 3}
+	it := m.Iter()
+	k, _, _ := it()
+	for key := range m {
+		if key != k {
+			delete(m, key)
+			break
+		}
+	}
+	if c := it.Count(); c != 1 {
+		t.Errorf("expected 1, got %d", c)
+	}
+}
+
+func TestCacheIter(t *testing.T) {
+	cache := NewCache[int, int](3)
+	cache.Put(1, 4)
+	cache.Put(2, 5)
+	cache.Put(3, 6)
+	k, v, ok := cache.Iter()()
+	if !ok || k != 3 || v != 6 {
+		t.Errorf("cache.Iter() = %d, %d, %v, want 3, 6, true", k, v, ok)
+	}
+	k, v, ok = cache.ReverseIter()()
+	if !ok || k != 1 || v != 4 {
+		t.Errorf("cache.ReverseIter() = %d, %d, %v, want 1, 4, true", k, v, ok)
+	}
+}
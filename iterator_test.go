@@ -1,6 +1,9 @@
 package collection
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func TestAny(t *testing.T) {
 	var a Vec[int]
@@ -140,3 +143,120 @@ func TestTap(t *testing.T) {
 		t.Errorf("expected 2, got %d", ind)
 	}
 }
+
+func TestSeq(t *testing.T) {
+	a := Vec[int]{1, 2, 3, 4}
+	var got Vec[int]
+	for v := range a.Iter().Seq() {
+		if v == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	if got.Len() != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+}
+
+func TestToChan(t *testing.T) {
+	a := Vec[int]{1, 2, 3}
+	var got Vec[int]
+	for v := range a.Iter().ToChan(context.Background(), 0) {
+		got = append(got, v)
+	}
+	if got.Len() != 3 || got[2] != 3 {
+		t.Errorf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestToChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	infinite := Iterator[int](func() (int, bool) { return 1, true })
+	ch := infinite.ToChan(ctx, 0)
+	<-ch
+	cancel()
+	// the producer must close the channel after the cancellation
+	for range ch {
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	infinite := Iterator[int](func() (int, bool) {
+		count++
+		if count == 5 {
+			cancel()
+		}
+		return count, true
+	})
+	v, err := infinite.CollectContext(ctx)
+	if err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+	if v.Len() != 5 {
+		t.Errorf("expected 5, got %d", v.Len())
+	}
+	if c, err := infinite.CountContext(ctx); c != 0 || err != context.Canceled {
+		t.Errorf("expected 0, %v, got %d, %v", context.Canceled, c, err)
+	}
+}
+
+func TestForEachContext(t *testing.T) {
+	a := Vec[int]{1, 2, 3}
+	sum := 0
+	if err := a.Iter().ForEachContext(context.Background(), func(i int) { sum += i }); err != nil || sum != 6 {
+		t.Errorf("expected 6, nil, got %d, %v", sum, err)
+	}
+}
+
+func TestCycle(t *testing.T) {
+	a := Vec[int]{1, 2, 3}
+	b := a.Iter().Cycle().Take(7).Collect()
+	for i, v := range []int{1, 2, 3, 1, 2, 3, 1} {
+		if b[i] != v {
+			t.Errorf("expected %d at %d, got %d", v, i, b[i])
+		}
+	}
+	if _, ok := (Vec[int]{}).Iter().Cycle()(); ok {
+		t.Errorf("expected false, got true")
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	a := Vec[int]{4, 5, 6}
+	a.Iter().Enumerate().ForEach(func(i, v int) {
+		if a[i] != v {
+			t.Errorf("expected %d at %d, got %d", a[i], i, v)
+		}
+	})
+}
+
+func TestIntersperse(t *testing.T) {
+	a := Vec[int]{1, 2, 3}
+	b := a.Iter().Intersperse(0).Collect()
+	for i, v := range []int{1, 0, 2, 0, 3} {
+		if b[i] != v {
+			t.Errorf("expected %d at %d, got %d", v, i, b[i])
+		}
+	}
+	if b.Len() != 5 {
+		t.Errorf("expected 5, got %d", b.Len())
+	}
+}
+
+func TestStepBy(t *testing.T) {
+	a := Vec[int]{0, 1, 2, 3, 4, 5, 6}
+	b := a.Iter().StepBy(3).Collect()
+	if b.Len() != 3 || b[1] != 3 || b[2] != 6 {
+		t.Errorf("expected [0 3 6], got %v", b)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	a := Vec[int]{1, 2, 3, 4, 5}
+	even, odd := a.Iter().Partition(func(i int) bool { return i%2 == 0 })
+	if even.Len() != 2 || odd.Len() != 3 || even[1] != 4 || odd[2] != 5 {
+		t.Errorf("expected [2 4] [1 3 5], got %v %v", even, odd)
+	}
+}
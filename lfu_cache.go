@@ -0,0 +1,180 @@
+package collection
+
+// LFUCache implements a least frequently used cache.
+// When the cache is full the item accessed the least number of times is evicted,
+// between items with the same number of accesses the least recently used one is evicted.
+// All the operations are O(1).
+type LFUCache[K comparable, V any] struct {
+	cacheRecorder[K, V]
+	size  int
+	items map[K]*lfuItem[K, V]
+	// buckets groups the items by frequency, in increasing order of frequency
+	buckets DLList[*lfuBucket[K, V]]
+}
+
+// lfuBucket holds the items accessed `freq` times, from the most recently used to the least one.
+type lfuBucket[K comparable, V any] struct {
+	freq  int
+	items DLList[*lfuItem[K, V]]
+}
+
+type lfuItem[K comparable, V any] struct {
+	key    K
+	val    V
+	bucket *Element[*lfuBucket[K, V]]
+	elem   *Element[*lfuItem[K, V]]
+}
+
+// NewLFUCache creates a new LFUCache.
+// The size must be positive, otherwise it panics.
+func NewLFUCache[K comparable, V any](size int) *LFUCache[K, V] {
+	mustBePositive(size)
+	return &LFUCache[K, V]{size: size, items: make(map[K]*lfuItem[K, V])}
+}
+
+// Clear removes all items from the cache.
+func (c *LFUCache[K, V]) Clear() {
+	cleared := c.items
+	c.items = make(map[K]*lfuItem[K, V])
+	c.buckets.Clear()
+	for key, item := range cleared {
+		c.evicted(key, item.val, EvictedDeleted)
+	}
+}
+
+// Contains checks if the key is in the cache, without counting it as an access.
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Delete removes the key from the cache. It returns false if the key was not in the cache.
+func (c *LFUCache[K, V]) Delete(key K) bool {
+	item, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.remove(item, EvictedDeleted)
+	return true
+}
+
+// Get returns the value for the given key if present in the cache.
+func (c *LFUCache[K, V]) Get(key K) (val V, ok bool) {
+	item, ok := c.items[key]
+	c.recordLookup(key, ok)
+	if !ok {
+		return val, ok
+	}
+	c.touch(item)
+	return item.val, ok
+}
+
+// GetOrAdd returns the value for the given key if present in the cache.
+// If not, it adds the value returned by f and returns it.
+func (c *LFUCache[K, V]) GetOrAdd(key K, f func() V) V {
+	if val, ok := c.Get(key); ok {
+		return val
+	}
+	val := c.load(key, f)
+	c.Put(key, val)
+	return val
+}
+
+// IsEmpty returns true if the cache is empty.
+func (c *LFUCache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// IsFull returns true if the cache is full.
+func (c *LFUCache[K, V]) IsFull() bool {
+	return len(c.items) >= c.size
+}
+
+// Iter returns an iterator over the key/value pairs in the cache.
+// The pairs are returned from the most frequently used to the least one,
+// items with the same frequency from the most recently used to the least one.
+func (c *LFUCache[K, V]) Iter() Iterator2[K, V] {
+	bucket := c.buckets.BackElement()
+	var item *Element[*lfuItem[K, V]]
+	if bucket != nil {
+		item = bucket.Value().items.FrontElement()
+	}
+	return func() (k K, v V, ok bool) {
+		for item == nil {
+			if bucket == nil {
+				return k, v, false
+			}
+			if bucket = bucket.Prev(); bucket != nil {
+				item = bucket.Value().items.FrontElement()
+			}
+		}
+		k, v, item = item.Value().key, item.Value().val, item.Next()
+		return k, v, true
+	}
+}
+
+// Len returns the number of items in the cache.
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Peek returns the value for the given key if present in the cache, without counting it as an access.
+func (c *LFUCache[K, V]) Peek(key K) (val V, ok bool) {
+	item, ok := c.items[key]
+	if !ok {
+		return val, ok
+	}
+	return item.val, ok
+}
+
+// Put adds the given key-value pair to the cache, it counts as an access of the key.
+func (c *LFUCache[K, V]) Put(key K, val V) {
+	c.recordPut(key)
+	if item, ok := c.items[key]; ok {
+		item.val = val
+		c.touch(item)
+		return
+	}
+	if len(c.items) >= c.size {
+		least := c.buckets.FrontElement().Value().items.BackElement()
+		c.remove(least.Value(), EvictedCapacity)
+	}
+	first := c.buckets.FrontElement()
+	if first == nil || first.Value().freq != 1 {
+		first = c.buckets.PushFront(&lfuBucket[K, V]{freq: 1})
+	}
+	item := &lfuItem[K, V]{key: key, val: val, bucket: first}
+	item.elem = first.Value().items.PushFront(item)
+	c.items[key] = item
+}
+
+// Stats returns a snapshot of the statistics of the cache. The weight is the number of items.
+func (c *LFUCache[K, V]) Stats() CacheStats {
+	return c.snapshot(len(c.items))
+}
+
+// remove removes the item from the cache and reports the eviction.
+func (c *LFUCache[K, V]) remove(item *lfuItem[K, V], reason EvictionReason) {
+	bucket := item.bucket.Value()
+	bucket.items.Remove(item.elem)
+	if bucket.items.IsEmpty() {
+		c.buckets.Remove(item.bucket)
+	}
+	delete(c.items, item.key)
+	c.evicted(item.key, item.val, reason)
+}
+
+// touch moves the item to the bucket of the next frequency.
+func (c *LFUCache[K, V]) touch(item *lfuItem[K, V]) {
+	current := item.bucket
+	next := current.Next()
+	if freq := current.Value().freq + 1; next == nil || next.Value().freq != freq {
+		next = c.buckets.InsertAfter(&lfuBucket[K, V]{freq: freq}, current)
+	}
+	current.Value().items.Remove(item.elem)
+	if current.Value().items.IsEmpty() {
+		c.buckets.Remove(current)
+	}
+	item.bucket = next
+	item.elem = next.Value().items.PushFront(item)
+}
@@ -0,0 +1,41 @@
+package collection
+
+import "testing"
+
+func TestLFUCacheEvictsLeastFrequent(t *testing.T) {
+	cache := NewLFUCache[int, int](3)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Put(3, 3)
+	cache.Get(1)
+	cache.Get(1)
+	cache.Get(3)
+	cache.Put(4, 4) // 2 is the least frequent
+	if cache.Contains(2) {
+		t.Errorf("cache.Contains(2) = %t, want %t", true, false)
+	}
+	cache.Get(4)
+	cache.Put(5, 5) // 3 and 4 have the same frequency, 3 is the least recent
+	if cache.Contains(3) {
+		t.Errorf("cache.Contains(3) = %t, want %t", true, false)
+	}
+	keys := cache.Iter().Keys().Collect()
+	for ind, v := range []int{1, 4, 5} {
+		if keys[ind] != v {
+			t.Errorf("cache.Iter()[%d] = %d, want %d", ind, keys[ind], v)
+		}
+	}
+}
+
+func TestLFUCachePeekDoesNotCount(t *testing.T) {
+	cache := NewLFUCache[int, int](2)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Get(2)
+	cache.Peek(1)
+	cache.Peek(1)
+	cache.Put(3, 3) // 1 is the least frequent
+	if cache.Contains(1) {
+		t.Errorf("cache.Contains(1) = %t, want %t", true, false)
+	}
+}
@@ -1,8 +1,10 @@
 package collection
 
+import "iter"
+
 // DLList is a doubly linked list. It is based on the linked list implementation.
 // It can be used as a stack and/or a queue.
-// All the operations are O(1), even when the size of the list is large.
+// The operations at both ends, or through an `Element`, are O(1), even when the size of the list is large.
 //
 // The zero value for DLList is an empty list ready to use.
 //    var queue collection.DLList[int]
@@ -14,9 +16,33 @@ package collection
 //    fmt.Println(queue.PopFront()) // 3, true
 //    fmt.Println(queue.PopFront()) // 0, false
 type DLList[T any] struct {
-	head *node[T]
-	tail *node[T]
+	head *Element[T]
+	tail *Element[T]
 	size int
+	// owner is shared by the elements of the list, it's created when the first element is added.
+	owner *listOwner[T]
+}
+
+// All returns an `iter.Seq2` over the positions and values of the list, from front to back.
+func (ll *DLList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for n := ll.head; n != nil; n = n.next {
+			if !yield(i, n.value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// At returns the value at position `i`, walking from the nearer end of the list.
+// If `i` is out of range the zero value is returned and false.
+func (ll *DLList[T]) At(i int) (T, bool) {
+	if n := ll.element(i); n != nil {
+		return n.value, true
+	}
+	return *new(T), false
 }
 
 // Back returns the last element of the list.
@@ -28,13 +54,34 @@ func (ll *DLList[T]) Back() (T, bool) {
 	return ll.tail.value, true
 }
 
-// Clear removes all elements from the list.
+// BackElement returns the last element of the list or nil if the list is empty.
+func (ll *DLList[T]) BackElement() *Element[T] {
+	return ll.tail
+}
+
+// Clear removes all elements from the list, they stop being valid handles of the list.
 func (ll *DLList[T]) Clear() {
+	ll.disown()
 	ll.head = nil
 	ll.tail = nil
 	ll.size = 0
 }
 
+// Cursor returns a new cursor over the list, positioned before the first element.
+func (ll *DLList[T]) Cursor() *ListCursor[T] {
+	return &ListCursor[T]{list: ll}
+}
+
+// Find returns the first element, from front to back, whose value satisfies the test, or nil if none does.
+func (ll *DLList[T]) Find(test func(item T) bool) *Element[T] {
+	for n := ll.head; n != nil; n = n.next {
+		if test(n.value) {
+			return n
+		}
+	}
+	return nil
+}
+
 // Front returns the first element of the list.
 // If the list is empty, the zero value is returned and false.
 func (ll *DLList[T]) Front() (T, bool) {
@@ -44,6 +91,29 @@ func (ll *DLList[T]) Front() (T, bool) {
 	return ll.head.value, true
 }
 
+// FrontElement returns the first element of the list or nil if the list is empty.
+func (ll *DLList[T]) FrontElement() *Element[T] {
+	return ll.head
+}
+
+// InsertAfter adds a new element with the value `v` after `mark` and returns it.
+// If `mark` is not an element of the list, the list is not modified and nil is returned.
+func (ll *DLList[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	if !ll.contains(mark) {
+		return nil
+	}
+	return ll.insertAfter(mark, &Element[T]{value: v})
+}
+
+// InsertBefore adds a new element with the value `v` before `mark` and returns it.
+// If `mark` is not an element of the list, the list is not modified and nil is returned.
+func (ll *DLList[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	if !ll.contains(mark) {
+		return nil
+	}
+	return ll.insertBefore(mark, &Element[T]{value: v})
+}
+
 // IsEmpty returns true if the list is empty.
 func (ll *DLList[T]) IsEmpty() bool {
 	return ll.size == 0
@@ -67,6 +137,46 @@ func (ll *DLList[T]) Len() int {
 	return ll.size
 }
 
+// MoveAfter moves the element `e` after `mark`.
+// If one of them is not an element of the list, or they are the same, the list is not modified.
+func (ll *DLList[T]) MoveAfter(e, mark *Element[T]) {
+	if e == mark || !ll.contains(e) || !ll.contains(mark) {
+		return
+	}
+	ll.remove(e)
+	ll.insertAfter(mark, e)
+}
+
+// MoveBefore moves the element `e` before `mark`.
+// If one of them is not an element of the list, or they are the same, the list is not modified.
+func (ll *DLList[T]) MoveBefore(e, mark *Element[T]) {
+	if e == mark || !ll.contains(e) || !ll.contains(mark) {
+		return
+	}
+	ll.remove(e)
+	ll.insertBefore(mark, e)
+}
+
+// MoveToBack moves the element `e` at the back of the list.
+// If `e` is not an element of the list, the list is not modified.
+func (ll *DLList[T]) MoveToBack(e *Element[T]) {
+	if e == ll.tail || !ll.contains(e) {
+		return
+	}
+	ll.remove(e)
+	ll.linkBack(e)
+}
+
+// MoveToFront moves the element `e` at the front of the list.
+// If `e` is not an element of the list, the list is not modified.
+func (ll *DLList[T]) MoveToFront(e *Element[T]) {
+	if e == ll.head || !ll.contains(e) {
+		return
+	}
+	ll.remove(e)
+	ll.linkFront(e)
+}
+
 // PopBack removes the last element from the list.
 // If the second return value is false, the list is empty and the zero value is returned.
 func (ll *DLList[T]) PopBack() (T, bool) {
@@ -80,7 +190,9 @@ func (ll *DLList[T]) PopBack() (T, bool) {
 	} else {
 		ll.tail = n.prev
 		ll.tail.next = nil
+		n.prev = nil
 	}
+	n.owner = nil
 	ll.size--
 	return n.value, true
 }
@@ -98,14 +210,205 @@ func (ll *DLList[T]) PopFront() (T, bool) {
 	} else {
 		ll.head = n.next
 		ll.head.prev = nil
+		n.next = nil
 	}
+	n.owner = nil
 	ll.size--
 	return n.value, true
 }
 
-// PushBack adds a new element at the back of the list.
-func (ll *DLList[T]) PushBack(v T) {
-	n := &node[T]{value: v}
+// PushBack adds a new element at the back of the list and returns it.
+func (ll *DLList[T]) PushBack(v T) *Element[T] {
+	n := &Element[T]{value: v}
+	ll.linkBack(n)
+	return n
+}
+
+// PushBackList adds a copy of the values of the other list at the back of the list.
+// The lists can be the same. Use `Splice` to move the elements in O(1) instead.
+func (ll *DLList[T]) PushBackList(other *DLList[T]) {
+	for i, n := other.size, other.head; i > 0; i, n = i-1, n.next {
+		ll.PushBack(n.value)
+	}
+}
+
+// PushFront adds a new element at the front of the list and returns it.
+func (ll *DLList[T]) PushFront(v T) *Element[T] {
+	n := &Element[T]{value: v}
+	ll.linkFront(n)
+	return n
+}
+
+// PushFrontList adds a copy of the values of the other list at the front of the list, keeping their order.
+// The lists can be the same.
+func (ll *DLList[T]) PushFrontList(other *DLList[T]) {
+	for i, n := other.size, other.tail; i > 0; i, n = i-1, n.prev {
+		ll.PushFront(n.value)
+	}
+}
+
+// Remove removes the element `e` from the list and returns its value.
+// The second returned value is false if `e` is not an element of the list, in this case the list is not modified.
+func (ll *DLList[T]) Remove(e *Element[T]) (T, bool) {
+	if !ll.contains(e) {
+		return *new(T), false
+	}
+	ll.remove(e)
+	return e.value, true
+}
+
+// RemoveIf removes all the elements whose value satisfies the test and returns how many were removed.
+func (ll *DLList[T]) RemoveIf(test func(item T) bool) int {
+	removed := 0
+	for n := ll.head; n != nil; {
+		next := n.next
+		if test(n.value) {
+			ll.remove(n)
+			removed++
+		}
+		n = next
+	}
+	return removed
+}
+
+// Reverse reverses the order of the elements in place. The elements keep being valid handles.
+func (ll *DLList[T]) Reverse() {
+	for n := ll.head; n != nil; n = n.prev {
+		n.prev, n.next = n.next, n.prev
+	}
+	ll.head, ll.tail = ll.tail, ll.head
+}
+
+// ReverseIter returns a new iterator for the list, iterating the values from back to front.
+func (ll *DLList[T]) ReverseIter() Iterator[T] {
+	cur_node := ll.tail
+	return func() (T, bool) {
+		if cur_node == nil {
+			return *new(T), false
+		}
+		v := cur_node.value
+		cur_node = cur_node.prev
+		return v, true
+	}
+}
+
+// Rotate moves the last `n` elements at the front of the list, keeping their order.
+// If `n` is negative the first `-n` elements are moved at the back.
+// Only the links are changed, so it takes O(min(n, Len()-n)) and the elements keep being valid handles.
+func (ll *DLList[T]) Rotate(n int) {
+	if ll.size < 2 {
+		return
+	}
+	n %= ll.size
+	if n < 0 {
+		n += ll.size
+	}
+	if n == 0 {
+		return
+	}
+	head := ll.element(ll.size - n)
+	// close the ring and open it before the new head
+	ll.tail.next, ll.head.prev = ll.head, ll.tail
+	ll.head, ll.tail = head, head.prev
+	ll.head.prev, ll.tail.next = nil, nil
+}
+
+// Seq returns an `iter.Seq` over the values of the list, from front to back.
+func (ll *DLList[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := ll.head; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Splice moves all the elements of the other list at the back of the list in O(1), leaving the other list empty.
+// The elements keep being valid handles, but of the list.
+func (ll *DLList[T]) Splice(other *DLList[T]) {
+	if other == ll || other.size == 0 {
+		return
+	}
+	// the owner of the moved elements is forwarded to the owner of the list
+	other.owner.list, other.owner.next = nil, ll.own()
+	other.owner = nil
+	if ll.size == 0 {
+		ll.head = other.head
+	} else {
+		ll.tail.next = other.head
+		other.head.prev = ll.tail
+	}
+	ll.tail = other.tail
+	ll.size += other.size
+	other.head, other.tail, other.size = nil, nil, 0
+}
+
+// Size returns the number of elements in the list.
+//
+// Deprecated: Size is deprecated, use Len instead.
+func (ll *DLList[T]) Size() int {
+	return ll.size
+}
+
+// ToVec returns a `Vec` with the values of the list, from front to back.
+func (ll *DLList[T]) ToVec() Vec[T] {
+	v := make(Vec[T], 0, ll.size)
+	for n := ll.head; n != nil; n = n.next {
+		v = append(v, n.value)
+	}
+	return v
+}
+
+// NewDLList returns a new list with the values, from front to back.
+func NewDLList[T any](values ...T) *DLList[T] {
+	ll := &DLList[T]{}
+	for _, v := range values {
+		ll.PushBack(v)
+	}
+	return ll
+}
+
+// NewDLListFromIter will collect all the values of the iterator to a new list.
+func NewDLListFromIter[T any](it Iterator[T]) *DLList[T] {
+	ll := &DLList[T]{}
+	for v, ok := it(); ok; v, ok = it() {
+		ll.PushBack(v)
+	}
+	return ll
+}
+
+// contains checks, in O(1), that the element belongs to the list.
+func (ll *DLList[T]) contains(e *Element[T]) bool {
+	if e == nil || e.owner == nil {
+		return false
+	}
+	// replace a forwarded owner with the last one, so the next checks are O(1)
+	for e.owner.next != nil {
+		e.owner = e.owner.next
+	}
+	return e.owner.list == ll
+}
+
+// disown detaches all the elements from the list in O(1), by emptying their shared owner.
+func (ll *DLList[T]) disown() {
+	if ll.owner != nil {
+		ll.owner.list = nil
+		ll.owner = nil
+	}
+}
+
+// own returns the owner to assign to the elements added to the list.
+func (ll *DLList[T]) own() *listOwner[T] {
+	if ll.owner == nil {
+		ll.owner = &listOwner[T]{list: ll}
+	}
+	return ll.owner
+}
+
+// linkBack adds the element `n`, which is not in any list, at the back of the list.
+func (ll *DLList[T]) linkBack(n *Element[T]) {
+	n.owner = ll.own()
 	if ll.size == 0 {
 		ll.head = n
 		ll.tail = n
@@ -117,9 +420,9 @@ func (ll *DLList[T]) PushBack(v T) {
 	ll.size++
 }
 
-// PushFront adds a new element at the front of the list.
-func (ll *DLList[T]) PushFront(v T) {
-	n := &node[T]{value: v}
+// linkFront adds the element `n`, which is not in any list, at the front of the list.
+func (ll *DLList[T]) linkFront(n *Element[T]) {
+	n.owner = ll.own()
 	if ll.size == 0 {
 		ll.head = n
 		ll.tail = n
@@ -131,29 +434,128 @@ func (ll *DLList[T]) PushFront(v T) {
 	ll.size++
 }
 
-// ReverseIter returns a new iterator for the list, iterating the values from back to front.
-func (ll *DLList[T]) ReverseIter() Iterator[T] {
-	cur_node := ll.tail
-	return func() (T, bool) {
-		if cur_node == nil {
-			return *new(T), false
+// element returns the element at position `i`, walking from the nearer end, or nil if `i` is out of range.
+func (ll *DLList[T]) element(i int) *Element[T] {
+	if i < 0 || i >= ll.size {
+		return nil
+	}
+	if i < ll.size/2 {
+		n := ll.head
+		for ; i > 0; i-- {
+			n = n.next
 		}
-		v := cur_node.value
-		cur_node = cur_node.prev
-		return v, true
+		return n
 	}
+	n := ll.tail
+	for i = ll.size - 1 - i; i > 0; i-- {
+		n = n.prev
+	}
+	return n
 }
 
-// Size returns the number of elements in the list.
-//
-// Deprecated: Size is deprecated, use Len instead.
-func (ll *DLList[T]) Size() int {
-	return ll.size
+// insertAfter adds the element `n`, which is not in any list, after the element `mark`, which must be in the list.
+func (ll *DLList[T]) insertAfter(mark, n *Element[T]) *Element[T] {
+	n.owner, n.prev, n.next = ll.own(), mark, mark.next
+	if mark.next == nil {
+		ll.tail = n
+	} else {
+		mark.next.prev = n
+	}
+	mark.next = n
+	ll.size++
+	return n
 }
 
-// node is a helper struct that holds the value and the links to the next and previous nodes.
-type node[T any] struct {
+// insertBefore adds the element `n`, which is not in any list, before the element `mark`, which must be in the list.
+func (ll *DLList[T]) insertBefore(mark, n *Element[T]) *Element[T] {
+	n.owner, n.prev, n.next = ll.own(), mark.prev, mark
+	if mark.prev == nil {
+		ll.head = n
+	} else {
+		mark.prev.next = n
+	}
+	mark.prev = n
+	ll.size++
+	return n
+}
+
+// remove unlinks the element `n`, which must be in the list.
+func (ll *DLList[T]) remove(n *Element[T]) {
+	if n.prev == nil {
+		ll.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		ll.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.owner, n.prev, n.next = nil, nil, nil
+	ll.size--
+}
+
+// Element is an element of a `DLList`, it is returned when a value is added to the list.
+// It can be used as a handle to remove or move the value in O(1), or to insert values next to it.
+// The methods of the list ignore the elements that don't belong to it: the elements of other lists,
+// and the elements removed from it with `Remove`, the `Pop` methods or `Clear`.
+type Element[T any] struct {
 	value T
-	prev  *node[T]
-	next  *node[T]
+	// owner tells the list the element belongs to, it's nil once removed
+	owner *listOwner[T]
+	prev  *Element[T]
+	next  *Element[T]
+}
+
+// listOwner is shared by the elements of a list, so they can be moved to another list,
+// or detached from the list, in O(1) by changing only the owner.
+// When the elements are moved by `Splice`, their owner is forwarded to the owner of the other list with `next`.
+type listOwner[T any] struct {
+	list *DLList[T]
+	next *listOwner[T]
+}
+
+// Next returns the next element of the list or nil.
+// It returns nil also if the element was removed from its list.
+func (e *Element[T]) Next() *Element[T] {
+	if !e.attached() {
+		return nil
+	}
+	return e.next
+}
+
+// Prev returns the previous element of the list or nil.
+// It returns nil also if the element was removed from its list.
+func (e *Element[T]) Prev() *Element[T] {
+	if !e.attached() {
+		return nil
+	}
+	return e.prev
+}
+
+// SetValue replaces the value of the element.
+func (e *Element[T]) SetValue(v T) {
+	e.value = v
+}
+
+// Value returns the value of the element.
+func (e *Element[T]) Value() T {
+	return e.value
+}
+
+// attached checks if the element belongs to a list.
+func (e *Element[T]) attached() bool {
+	return e.list() != nil
+}
+
+// list returns the list the element belongs to, or nil.
+func (e *Element[T]) list() *DLList[T] {
+	if e.owner == nil {
+		return nil
+	}
+	owner := e.owner
+	for owner.next != nil {
+		owner = owner.next
+	}
+	return owner.list
 }
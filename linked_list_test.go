@@ -157,3 +157,227 @@ func TestLen(t *testing.T) {
 		t.Errorf("queue.len = %d, want %d", s, 3)
 	}
 }
+
+func TestSeqAndAll(t *testing.T) {
+	var queue DLList[int]
+	queue.PushBack(1)
+	queue.PushBack(2)
+	queue.PushBack(3)
+	want := 1
+	for v := range queue.Seq() {
+		if v != want {
+			t.Errorf("queue.Seq() = %d, want %d", v, want)
+		}
+		want++
+	}
+	for i, v := range queue.All() {
+		if v != i+1 {
+			t.Errorf("queue.All()[%d] = %d, want %d", i, v, i+1)
+		}
+	}
+}
+
+func TestElementRemove(t *testing.T) {
+	var list DLList[int]
+	list.PushBack(1)
+	e := list.PushBack(2)
+	list.PushBack(3)
+	if v, ok := list.Remove(e); !ok || v != 2 {
+		t.Errorf("list.Remove() = %d, %v, want 2, true", v, ok)
+	}
+	if _, ok := list.Remove(e); ok {
+		t.Errorf("list.Remove() of a removed element = true, want false")
+	}
+	got := list.Iter().Collect()
+	if got.Len() != 2 || got[0] != 1 || got[1] != 3 || list.Len() != 2 {
+		t.Errorf("expected [1 3], got %v", got)
+	}
+}
+
+func TestElementMove(t *testing.T) {
+	var list DLList[int]
+	one := list.PushBack(1)
+	two := list.PushBack(2)
+	three := list.PushBack(3)
+	list.MoveToFront(three)
+	list.MoveToBack(one)
+	// 3 2 1
+	list.MoveAfter(three, two)
+	// 2 3 1
+	list.MoveBefore(one, three)
+	// 2 1 3
+	got := list.Iter().Collect()
+	for i, v := range []int{2, 1, 3} {
+		if got[i] != v {
+			t.Errorf("expected %d at %d, got %d", v, i, got[i])
+		}
+	}
+	if list.FrontElement() != two || list.BackElement() != three {
+		t.Errorf("unexpected front or back element")
+	}
+	if back, _ := list.ReverseIter()(); back != 3 {
+		t.Errorf("list.ReverseIter() first value = %d, want 3", back)
+	}
+}
+
+func TestElementInsert(t *testing.T) {
+	var list DLList[int]
+	two := list.PushFront(2)
+	list.InsertBefore(1, two)
+	e := list.InsertAfter(3, two)
+	e.SetValue(4)
+	if e.Prev() != two || two.Next() != e || e.Value() != 4 {
+		t.Errorf("unexpected links after insert")
+	}
+	if list.InsertAfter(5, &Element[int]{}) != nil {
+		t.Errorf("list.InsertAfter() with a foreign element should return nil")
+	}
+	if list.Len() != 3 {
+		t.Errorf("list.Len() = %d, want 3", list.Len())
+	}
+}
+
+func TestSplice(t *testing.T) {
+	var a, b DLList[int]
+	a.PushBack(1)
+	e := b.PushBack(2)
+	b.PushBack(3)
+	a.Splice(&b)
+	if a.Len() != 3 || !b.IsEmpty() {
+		t.Errorf("a.Len() = %d, b.Len() = %d, want 3, 0", a.Len(), b.Len())
+	}
+	a.MoveToFront(e)
+	a.PushBackList(&a)
+	a.PushFrontList(&DLList[int]{})
+	got := a.Iter().Collect()
+	for i, v := range []int{2, 1, 3, 2, 1, 3} {
+		if got[i] != v {
+			t.Errorf("expected %d at %d, got %d", v, i, got[i])
+		}
+	}
+}
+
+func TestNewDLList(t *testing.T) {
+	list := NewDLList(1, 2, 3)
+	if list.Len() != 3 {
+		t.Errorf("list.Len() = %d, want 3", list.Len())
+	}
+	list = NewDLListFromIter(list.Iter().Filter(func(i int) bool { return i > 1 }))
+	if v, ok := list.Front(); !ok || v != 2 || list.Len() != 2 {
+		t.Errorf("list.Front() = %d, %v, want 2, true", v, ok)
+	}
+}
+
+func TestAt(t *testing.T) {
+	list := NewDLList(0, 1, 2, 3, 4)
+	for i := 0; i < 5; i++ {
+		if v, ok := list.At(i); !ok || v != i {
+			t.Errorf("list.At(%d) = %d, %v, want %d, true", i, v, ok, i)
+		}
+	}
+	if _, ok := list.At(5); ok {
+		t.Errorf("list.At(5) = true, want false")
+	}
+}
+
+func TestRemoveIfAndFind(t *testing.T) {
+	list := NewDLList(1, 2, 3, 4, 5)
+	if n := list.RemoveIf(func(i int) bool { return i%2 == 1 }); n != 3 {
+		t.Errorf("list.RemoveIf() = %d, want 3", n)
+	}
+	if e := list.Find(func(i int) bool { return i > 2 }); e == nil || e.Value() != 4 {
+		t.Errorf("list.Find() = %v, want 4", e)
+	}
+	if e := list.Find(func(i int) bool { return i > 4 }); e != nil {
+		t.Errorf("list.Find() = %v, want nil", e)
+	}
+	if v := list.ToVec(); v.Len() != 2 || v[0] != 2 || v[1] != 4 {
+		t.Errorf("list.ToVec() = %v, want [2 4]", v)
+	}
+}
+
+func TestReverseAndRotate(t *testing.T) {
+	list := NewDLList(1, 2, 3, 4, 5)
+	list.Reverse()
+	list.Rotate(2)
+	// 5 4 3 2 1 -> 2 1 5 4 3
+	got := list.ToVec()
+	for i, v := range []int{2, 1, 5, 4, 3} {
+		if got[i] != v {
+			t.Errorf("expected %d at %d, got %d", v, i, got[i])
+		}
+	}
+	list.Rotate(-7)
+	// 2 1 5 4 3 -> 5 4 3 2 1
+	got = list.ReverseIter().Collect()
+	for i, v := range []int{1, 2, 3, 4, 5} {
+		if got[i] != v {
+			t.Errorf("expected %d at %d in reverse, got %d", v, i, got[i])
+		}
+	}
+}
+
+func TestForeignElements(t *testing.T) {
+	a := NewDLList(1, 2, 3)
+	b := NewDLList(7, 8, 9)
+	if _, ok := a.Remove(b.FrontElement()); ok {
+		t.Errorf("a.Remove() with an element of b should return false")
+	}
+	a.MoveToFront(b.BackElement())
+	a.MoveBefore(b.FrontElement(), a.BackElement())
+	a.MoveAfter(a.FrontElement(), b.FrontElement())
+	if got := a.ToVec(); a.Len() != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("a = %v, want [1 2 3]", got)
+	}
+	if got := b.ToVec(); b.Len() != 3 || got[0] != 7 || got[1] != 8 || got[2] != 9 {
+		t.Errorf("b = %v, want [7 8 9]", got)
+	}
+
+	stale := a.FrontElement()
+	a.Clear()
+	a.PushBack(10)
+	if _, ok := a.Remove(stale); ok || a.Len() != 1 {
+		t.Errorf("a.Remove() with an element of the cleared list should return false")
+	}
+	if stale.Next() != nil {
+		t.Errorf("the elements of a cleared list should be detached")
+	}
+
+	popped := b.BackElement()
+	b.PopBack()
+	b.MoveToFront(popped)
+	if got := b.ToVec(); b.Len() != 2 || got[0] != 7 || got[1] != 8 {
+		t.Errorf("b = %v, want [7 8]", got)
+	}
+
+	moved := b.FrontElement()
+	a.Splice(b)
+	if _, ok := b.Remove(moved); ok {
+		t.Errorf("b.Remove() with a spliced element should return false")
+	}
+	if v, ok := a.Remove(moved); !ok || v != 7 || a.Len() != 2 {
+		t.Errorf("a.Remove() = %d, %t, want %d, %t", v, ok, 7, true)
+	}
+}
+
+func TestSpliceOwners(t *testing.T) {
+	a, b, c := NewDLList(1), NewDLList(2), NewDLList(3)
+	fromB, fromC := b.FrontElement(), c.FrontElement()
+	b.Splice(c)
+	a.Splice(b)
+	if v, ok := a.Remove(fromC); !ok || v != 3 {
+		t.Errorf("a.Remove() = %d, %t, want %d, %t", v, ok, 3, true)
+	}
+	b.PushBack(4)
+	if _, ok := b.Remove(fromB); ok {
+		t.Errorf("b.Remove() with an element spliced into a should return false")
+	}
+	a.Clear()
+	a.PushBack(5)
+	if _, ok := a.Remove(fromB); ok || a.Len() != 1 {
+		t.Errorf("a.Remove() with an element of the cleared list should return false")
+	}
+	if fromB.Next() != nil || fromB.Prev() != nil {
+		t.Errorf("the spliced elements of a cleared list should be detached")
+	}
+}
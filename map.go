@@ -1,8 +1,26 @@
 package collection
 
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
 // Map is the same as `map` but with some methods.
 type Map[K comparable, V any] map[K]V
 
+// All returns an `iter.Seq2` over the key/value pairs of the map.
+// As with `range` the iteration order is not specified.
+func (m Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
 // Clear will delete all the key/value pairs in the map.
 func (m Map[K, V]) Clear() {
 	for k := range m {
@@ -10,12 +28,95 @@ func (m Map[K, V]) Clear() {
 	}
 }
 
+// Clone returns a new map with the same key/value pairs. The values are copied as with an assignment.
+func (m Map[K, V]) Clone() Map[K, V] {
+	result := make(Map[K, V], len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
+// DeleteIf deletes all the key/value pairs that satisfy the test and returns how many were deleted.
+func (m Map[K, V]) DeleteIf(test func(key K, val V) bool) int {
+	deleted := 0
+	for k, v := range m {
+		if test(k, v) {
+			delete(m, k)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// Equal checks if both the maps have the same keys, with values equal according to `eq`.
+func (m Map[K, V]) Equal(other Map[K, V], eq func(a, b V) bool) bool {
+	if len(m) != len(other) {
+		return false
+	}
+	for k, v := range m {
+		ov, ok := other[k]
+		if !ok || !eq(v, ov) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter will return a new map with the key/value pairs that satisfy the test.
+func (m Map[K, V]) Filter(test func(key K, val V) bool) Map[K, V] {
+	result := Map[K, V]{}
+	for k, v := range m {
+		if test(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// GetOr returns the value for the key, or `fallback` if the key is not in the map.
+func (m Map[K, V]) GetOr(key K, fallback V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return fallback
+}
+
+// GetOrInsert returns the value for the key. If the key is not in the map,
+// the value returned by `f` is inserted and returned.
+func (m Map[K, V]) GetOrInsert(key K, f func() V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	v := f()
+	m[key] = v
+	return v
+}
+
 // Has checks if the key is in the map.
 func (m Map[K, V]) Has(key K) bool {
 	_, ok := m[key]
 	return ok
 }
 
+// Iter will return a lazy iterator over the key/value pairs of the map.
+// The keys are collected when `Iter` is called, keys deleted from the map while iterating are skipped
+// and keys added while iterating are not yielded. As with `range` the iteration order is not specified.
+func (m Map[K, V]) Iter() Iterator2[K, V] {
+	keys := m.Keys()
+	current := 0
+	return func() (K, V, bool) {
+		for current < len(keys) {
+			k := keys[current]
+			current++
+			if v, ok := m[k]; ok {
+				return k, v, true
+			}
+		}
+		return *new(K), *new(V), false
+	}
+}
+
 // Keys will return a Vec with the keys of the map.
 func (m Map[K, V]) Keys() Vec[K] {
 	v := make(Vec[K], 0, len(m))
@@ -35,6 +136,28 @@ func (m Map[K, V]) IsEmpty() bool {
 	return len(m) == 0
 }
 
+// Merge will add all the key/value pairs of the other map to the receiver.
+// When a key is in both the maps, the value returned by `conflict` is kept.
+// If `conflict` is nil, the value of the other map is kept.
+func (m Map[K, V]) Merge(other Map[K, V], conflict func(key K, val, other V) V) {
+	for k, ov := range other {
+		if v, ok := m[k]; ok && conflict != nil {
+			m[k] = conflict(k, v, ov)
+		} else {
+			m[k] = ov
+		}
+	}
+}
+
+// Update sets the value for the key to the one returned by `f`, and returns it.
+// `f` receives the current value and whether the key is in the map.
+func (m Map[K, V]) Update(key K, f func(val V, ok bool) V) V {
+	v, ok := m[key]
+	v = f(v, ok)
+	m[key] = v
+	return v
+}
+
 // Values will return a Vec with the values of the map.
 func (m Map[K, V]) Values() Vec[V] {
 	v := make(Vec[V], 0, len(m))
@@ -43,3 +166,20 @@ func (m Map[K, V]) Values() Vec[V] {
 	}
 	return v
 }
+
+// Invert returns a new map where the keys are the values of `m` and the values are the keys.
+// If several keys have the same value, only one of them is kept, which one is not specified.
+func Invert[K comparable, V comparable](m Map[K, V]) Map[V, K] {
+	result := make(Map[V, K], len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// SortedKeys will return a Vec with the keys of the map in increasing order.
+func SortedKeys[K constraints.Ordered, V any](m Map[K, V]) Vec[K] {
+	keys := m.Keys()
+	Sort(keys)
+	return keys
+}
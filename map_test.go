@@ -0,0 +1,63 @@
+package collection
+
+import "testing"
+
+func TestMapGet(t *testing.T) {
+	m := Map[string, int]{"a": 1}
+	if v := m.GetOr("b", 5); v != 5 {
+		t.Errorf("m.GetOr(b) = %d, want 5", v)
+	}
+	if v := m.GetOrInsert("a", func() int { return 5 }); v != 1 {
+		t.Errorf("m.GetOrInsert(a) = %d, want 1", v)
+	}
+	if v := m.GetOrInsert("b", func() int { return 5 }); v != 5 || m["b"] != 5 {
+		t.Errorf("m.GetOrInsert(b) = %d, want 5", v)
+	}
+}
+
+func TestMapUpdate(t *testing.T) {
+	m := Map[string, int]{}
+	incr := func(v int, ok bool) int { return v + 1 }
+	m.Update("a", incr)
+	if v := m.Update("a", incr); v != 2 {
+		t.Errorf("m.Update(a) = %d, want 2", v)
+	}
+}
+
+func TestMapMerge(t *testing.T) {
+	m := Map[string, int]{"a": 1, "b": 2}
+	m.Merge(Map[string, int]{"b": 3, "c": 4}, func(k string, v, other int) int { return v + other })
+	if !m.Equal(Map[string, int]{"a": 1, "b": 5, "c": 4}, func(a, b int) bool { return a == b }) {
+		t.Errorf("unexpected merge result %v", m)
+	}
+	m.Merge(Map[string, int]{"a": 9}, nil)
+	if m["a"] != 9 {
+		t.Errorf("m[a] = %d, want 9", m["a"])
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	m := Map[string, int]{"a": 1, "b": 2, "c": 3}
+	even := func(k string, v int) bool { return v%2 == 0 }
+	if f := m.Filter(even); f.Len() != 1 || f["b"] != 2 {
+		t.Errorf("m.Filter() = %v", f)
+	}
+	c := m.Clone()
+	if n := m.DeleteIf(even); n != 1 || m.Has("b") {
+		t.Errorf("m.DeleteIf() = %d, map %v", n, m)
+	}
+	if c.Len() != 3 {
+		t.Errorf("clone modified, got %v", c)
+	}
+}
+
+func TestMapInvertAndSortedKeys(t *testing.T) {
+	m := Map[string, int]{"b": 1, "a": 2}
+	inv := Invert(m)
+	if inv[1] != "b" || inv[2] != "a" {
+		t.Errorf("Invert(m) = %v", inv)
+	}
+	if keys := SortedKeys(m); keys[0] != "a" || keys[1] != "b" {
+		t.Errorf("SortedKeys(m) = %v", keys)
+	}
+}
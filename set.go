@@ -1,5 +1,11 @@
 package collection
 
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
 // Set is the classic `set` data structure
 type Set[T comparable] Map[T, struct{}]
 
@@ -8,6 +14,30 @@ func (s Set[T]) Add(element T) {
 	s[element] = struct{}{}
 }
 
+// AddAll will add all the elements to the set
+func (s Set[T]) AddAll(elements ...T) {
+	for _, e := range elements {
+		s.Add(e)
+	}
+}
+
+// AddIter will consume the iterator and add all the values to the set
+func (s Set[T]) AddIter(it Iterator[T]) {
+	for v, ok := it(); ok; v, ok = it() {
+		s.Add(v)
+	}
+}
+
+// Any returns true as soon as an element satisfies the test, false otherwise.
+func (s Set[T]) Any(test func(elem T) bool) bool {
+	for e := range s {
+		if test(e) {
+			return true
+		}
+	}
+	return false
+}
+
 // Clear will delete all the set elements.
 func (s Set[T]) Clear() {
 	for k := range s {
@@ -15,11 +45,27 @@ func (s Set[T]) Clear() {
 	}
 }
 
+// Clone returns a new set with the same elements
+func (s Set[T]) Clone() Set[T] {
+	result := make(Set[T], len(s))
+	for e := range s {
+		result.Add(e)
+	}
+	return result
+}
+
 // Delete will remove the element from the set
 func (s Set[T]) Delete(elem T) {
 	delete(s, elem)
 }
 
+// DeleteAll will remove all the elements from the set
+func (s Set[T]) DeleteAll(elements ...T) {
+	for _, e := range elements {
+		delete(s, e)
+	}
+}
+
 // Difference will return a new set that will contain only the elements of the receiver that are not in the other
 func (s Set[T]) Difference(other Set[T]) Set[T] {
 	result := Set[T]{}
@@ -31,24 +77,90 @@ func (s Set[T]) Difference(other Set[T]) Set[T] {
 	return result
 }
 
+// DifferenceWith removes from the receiver all the elements of the other set.
+func (s Set[T]) DifferenceWith(other Set[T]) {
+	for elem := range other {
+		delete(s, elem)
+	}
+}
+
+// Equal checks if both the sets have the same elements.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubsetOf(other)
+}
+
+// Every will return false as soon as an element will fail the test, true otherwise.
+func (s Set[T]) Every(test func(elem T) bool) bool {
+	for e := range s {
+		if !test(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter will return a new set with the elements that satisfy the test
+func (s Set[T]) Filter(test func(elem T) bool) Set[T] {
+	result := Set[T]{}
+	for e := range s {
+		if test(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
+
 // Has checks if the element is in the set.
 func (m Set[T]) Has(element T) bool {
 	_, ok := m[element]
 	return ok
 }
 
-// Intersection will return a new set with all the elements that are part of both the sets
-func (s Set[T]) Intersection(other Set[T]) Set[T] {
+// Intersection will return a new set with all the elements that are part of the receiver and all the other sets
+func (s Set[T]) Intersection(others ...Set[T]) Set[T] {
 	result := Set[T]{}
+	// iterate the smallest set, the result can't be larger
+	smallest := s
+	for _, other := range others {
+		if other.Len() < smallest.Len() {
+			smallest = other
+		}
+	}
+next:
+	for elem := range smallest {
+		if !s.Has(elem) {
+			continue
+		}
+		for _, other := range others {
+			if !other.Has(elem) {
+				continue next
+			}
+		}
+		result.Add(elem)
+	}
+	return result
+}
+
+// IntersectWith removes from the receiver all the elements that are not in the other set.
+func (s Set[T]) IntersectWith(other Set[T]) {
+	for elem := range s {
+		if !other.Has(elem) {
+			delete(s, elem)
+		}
+	}
+}
+
+// IsDisjoint checks if the sets have no elements in common.
+func (s Set[T]) IsDisjoint(other Set[T]) bool {
 	if s.Len() > other.Len() {
 		s, other = other, s
 	}
 	for elem := range s {
 		if other.Has(elem) {
-			result.Add(elem)
+			return false
 		}
 	}
-	return result
+	return true
 }
 
 // IsEmpty checks if the set is empty
@@ -56,11 +168,86 @@ func (s Set[T]) IsEmpty() bool {
 	return len(s) == 0
 }
 
+// IsSubsetOf checks if all the elements of the receiver are in the other set.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for elem := range s {
+		if !other.Has(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSupersetOf checks if all the elements of the other set are in the receiver.
+func (s Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// Iter will return a lazy iterator over the elements of the set.
+// It follows the same rules of `Map.Iter`: the elements are collected when `Iter` is called
+// and the elements deleted while iterating are skipped.
+func (s Set[T]) Iter() Iterator[T] {
+	return Map[T, struct{}](s).Iter().Keys()
+}
+
 // Len is an alias to `len`
 func (s Set[T]) Len() int {
 	return len(s)
 }
 
+// Pop removes an arbitrary element from the set and returns it.
+// If the set is empty the zero value is returned and false.
+func (s Set[T]) Pop() (T, bool) {
+	for e := range s {
+		delete(s, e)
+		return e, true
+	}
+	return *new(T), false
+}
+
+// RemoveIf removes all the elements that satisfy the test and returns how many were removed.
+func (s Set[T]) RemoveIf(test func(elem T) bool) int {
+	removed := 0
+	for e := range s {
+		if test(e) {
+			delete(s, e)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Seq returns an `iter.Seq` over the elements of the set.
+// As with `range` the iteration order is not specified.
+func (s Set[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// SymmetricDifference will return a new set with the elements that are in only one of the sets
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := Set[T]{}
+	for elem := range s {
+		if !other.Has(elem) {
+			result.Add(elem)
+		}
+	}
+	for elem := range other {
+		if !s.Has(elem) {
+			result.Add(elem)
+		}
+	}
+	return result
+}
+
 // ToVec will collect the elements of the set to a `Vec`
 func (s Set[T]) ToVec() Vec[T] {
 	v := make(Vec[T], 0, s.Len())
@@ -70,18 +257,27 @@ func (s Set[T]) ToVec() Vec[T] {
 	return v
 }
 
-// Union returns a new set with all the the elements of both sets
-func (s Set[T]) Union(other Set[T]) Set[T] {
+// Union returns a new set with all the the elements of the receiver and the other sets
+func (s Set[T]) Union(others ...Set[T]) Set[T] {
 	result := Set[T]{}
 	for e := range s {
 		result.Add(e)
 	}
-	for e := range other {
-		result.Add(e)
+	for _, other := range others {
+		for e := range other {
+			result.Add(e)
+		}
 	}
 	return result
 }
 
+// UnionWith adds to the receiver all the elements of the other set.
+func (s Set[T]) UnionWith(other Set[T]) {
+	for e := range other {
+		s.Add(e)
+	}
+}
+
 // NewSet returns a new set from the list of values
 func NewSet[T comparable](values ...T) Set[T] {
 	set := Set[T]{}
@@ -99,3 +295,10 @@ func NewSetFromIter[T comparable](it Iterator[T]) Set[T] {
 	}
 	return set
 }
+
+// Sorted returns a `Vec` with the elements of the set in increasing order.
+func Sorted[T constraints.Ordered](s Set[T]) Vec[T] {
+	v := s.ToVec()
+	Sort(v)
+	return v
+}
@@ -0,0 +1,98 @@
+package collection
+
+import "testing"
+
+func TestSetUnionIntersection(t *testing.T) {
+	a, b, c := NewSet(1, 2, 3), NewSet(2, 3, 4), NewSet(3, 4, 5)
+	if u := a.Union(b, c); !u.Equal(NewSet(1, 2, 3, 4, 5)) {
+		t.Errorf("a.Union(b, c) = %v", u)
+	}
+	if i := a.Intersection(b, c); !i.Equal(NewSet(3)) {
+		t.Errorf("a.Intersection(b, c) = %v", i)
+	}
+	if i := a.Intersection(b); !i.Equal(NewSet(2, 3)) {
+		t.Errorf("a.Intersection(b) = %v", i)
+	}
+}
+
+func TestSetSymmetricDifference(t *testing.T) {
+	a, b := NewSet(1, 2, 3), NewSet(2, 3, 4)
+	if d := a.SymmetricDifference(b); !d.Equal(NewSet(1, 4)) {
+		t.Errorf("a.SymmetricDifference(b) = %v", d)
+	}
+}
+
+func TestSetRelations(t *testing.T) {
+	a, b, c := NewSet(1, 2), NewSet(1, 2, 3), NewSet(4)
+	if !a.IsSubsetOf(b) || b.IsSubsetOf(a) {
+		t.Errorf("unexpected IsSubsetOf results")
+	}
+	if !b.IsSupersetOf(a) || a.IsSupersetOf(b) {
+		t.Errorf("unexpected IsSupersetOf results")
+	}
+	if !a.IsDisjoint(c) || a.IsDisjoint(b) {
+		t.Errorf("unexpected IsDisjoint results")
+	}
+	if a.Equal(b) || !a.Equal(NewSet(2, 1)) {
+		t.Errorf("unexpected Equal results")
+	}
+}
+
+func TestSetInPlace(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	s.UnionWith(NewSet(4))
+	s.IntersectWith(NewSet(2, 3, 4, 5))
+	s.DifferenceWith(NewSet(3))
+	if !s.Equal(NewSet(2, 4)) {
+		t.Errorf("expected {2, 4}, got %v", s)
+	}
+}
+
+func TestSetIter(t *testing.T) {
+	s := NewSet(1, 2, 3)
+	if sum := s.Iter().Count(); sum != 3 {
+		t.Errorf("s.Iter().Count() = %d, want 3", sum)
+	}
+}
+
+func TestSetBulk(t *testing.T) {
+	s := NewSet[int]()
+	s.AddAll(1, 2, 3)
+	s.AddIter(Vec[int]{4, 5, 6}.Iter())
+	s.DeleteAll(1, 6)
+	if n := s.RemoveIf(func(e int) bool { return e%2 == 0 }); n != 2 {
+		t.Errorf("s.RemoveIf() = %d, want 2", n)
+	}
+	if v := Sorted(s); v.Len() != 2 || v[0] != 3 || v[1] != 5 {
+		t.Errorf("Sorted(s) = %v, want [3 5]", v)
+	}
+}
+
+func TestSetPredicates(t *testing.T) {
+	s := NewSet(1, 2, 3, 4)
+	if !s.Any(func(e int) bool { return e > 3 }) || s.Any(func(e int) bool { return e > 4 }) {
+		t.Errorf("unexpected Any results")
+	}
+	if !s.Every(func(e int) bool { return e > 0 }) || s.Every(func(e int) bool { return e > 1 }) {
+		t.Errorf("unexpected Every results")
+	}
+	if f := s.Filter(func(e int) bool { return e%2 == 0 }); !f.Equal(NewSet(2, 4)) {
+		t.Errorf("s.Filter() = %v, want {2, 4}", f)
+	}
+}
+
+func TestSetPopClone(t *testing.T) {
+	s := NewSet(1, 2)
+	c := s.Clone()
+	e, ok := s.Pop()
+	if !ok || s.Has(e) || s.Len() != 1 {
+		t.Errorf("s.Pop() = %d, %v, set %v", e, ok, s)
+	}
+	s.Pop()
+	if _, ok := s.Pop(); ok {
+		t.Errorf("s.Pop() on empty set = true, want false")
+	}
+	if c.Len() != 2 {
+		t.Errorf("clone modified, got %v", c)
+	}
+}
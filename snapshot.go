@@ -0,0 +1,99 @@
+package collection
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Codec encodes and decodes the entries of a cache snapshot, see `LRUCache.Snapshot`.
+// `GobCodec` and `JSONCodec` are provided, other encodings can be plugged in by implementing it.
+type Codec interface {
+	// NewEncoder returns an encoder writing to w, `Encode` is called once for every entry.
+	NewEncoder(w io.Writer) Encoder
+	// NewDecoder returns a decoder reading from r, `Decode` must return `io.EOF` after the last entry.
+	NewDecoder(r io.Reader) Decoder
+}
+
+// Encoder writes the values to a stream, like `gob.Encoder` and `json.Encoder`.
+type Encoder interface {
+	Encode(v any) error
+}
+
+// Decoder reads the values from a stream, like `gob.Decoder` and `json.Decoder`.
+type Decoder interface {
+	Decode(v any) error
+}
+
+var (
+	// GobCodec encodes the snapshots with `encoding/gob`.
+	// If the keys or values are interfaces, the concrete types must be registered with `gob.Register`.
+	GobCodec Codec = gobCodec{}
+	// JSONCodec encodes the snapshots with `encoding/json`, one entry per line.
+	JSONCodec Codec = jsonCodec{}
+)
+
+type gobCodec struct{}
+
+func (gobCodec) NewEncoder(w io.Writer) Encoder { return gob.NewEncoder(w) }
+func (gobCodec) NewDecoder(r io.Reader) Decoder { return gob.NewDecoder(r) }
+
+type jsonCodec struct{}
+
+func (jsonCodec) NewEncoder(w io.Writer) Encoder { return json.NewEncoder(w) }
+func (jsonCodec) NewDecoder(r io.Reader) Decoder { return json.NewDecoder(r) }
+
+// SnapshotEntry is an item of a cache snapshot.
+// Expires is the time the item expires, nil if it doesn't expire.
+type SnapshotEntry[K comparable, V any] struct {
+	Key     K          `json:"key"`
+	Value   V          `json:"value"`
+	Expires *time.Time `json:"expires,omitempty"`
+}
+
+// Snapshot writes the items of the cache to w, encoded with codec, so they can be loaded with `Restore`.
+// The items are written from the least recently used to the most recent one, together with their expiration time.
+// The expired items are skipped. Taking a snapshot does not change the order of the cache.
+func (c *LRUCache[K, V]) Snapshot(w io.Writer, codec Codec) error {
+	enc := codec.NewEncoder(w)
+	now := c.now()
+	for n := c.tail; n != nil; n = n.prev {
+		if n.expiredAt(now) {
+			continue
+		}
+		entry := SnapshotEntry[K, V]{Key: n.key, Value: n.val}
+		if !n.expires.IsZero() {
+			entry.Expires = &n.expires
+		}
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore reads the items written by `Snapshot` from r, decoded with codec, and adds them to the cache.
+// The restored items keep their recency order and are more recent than the items already in the cache.
+// They keep their expiration time too, the items expired in the meanwhile are skipped.
+// If the cache is smaller than the snapshot, the least recently used items are evicted as usual.
+//
+// If an error occurs, the items restored until then stay in the cache.
+func (c *LRUCache[K, V]) Restore(r io.Reader, codec Codec) error {
+	dec := codec.NewDecoder(r)
+	for {
+		var entry SnapshotEntry[K, V]
+		if err := dec.Decode(&entry); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if entry.Expires == nil {
+			c.PutWithTTL(entry.Key, entry.Value, 0)
+			continue
+		}
+		if ttl := entry.Expires.Sub(c.now()); ttl > 0 {
+			c.PutWithTTL(entry.Key, entry.Value, ttl)
+		}
+	}
+}
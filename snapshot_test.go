@@ -0,0 +1,100 @@
+package collection
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCacheSnapshotRestore(t *testing.T) {
+	for name, codec := range map[string]Codec{"gob": GobCodec, "json": JSONCodec} {
+		t.Run(name, func(t *testing.T) {
+			cache := NewCache[string, int](3)
+			cache.Put("a", 1)
+			cache.Put("b", 2)
+			cache.Put("c", 3)
+			cache.Get("a")
+			var buf bytes.Buffer
+			if err := cache.Snapshot(&buf, codec); err != nil {
+				t.Fatalf("cache.Snapshot() error = %v", err)
+			}
+			restored := NewCache[string, int](3)
+			if err := restored.Restore(&buf, codec); err != nil {
+				t.Fatalf("cache.Restore() error = %v", err)
+			}
+			keys := restored.IterKeys().Collect()
+			for ind, v := range []string{"a", "c", "b"} {
+				if keys[ind] != v {
+					t.Errorf("restored.IterKeys()[%d] = %s, want %s", ind, keys[ind], v)
+				}
+			}
+			if v, ok := restored.Get("c"); !ok || v != 3 {
+				t.Errorf("restored.Get(c) = %d, %t, want %d, %t", v, ok, 3, true)
+			}
+		})
+	}
+}
+
+func TestCacheRestoreSmaller(t *testing.T) {
+	cache := NewCache[int, int](0)
+	for i := range 5 {
+		cache.Put(i, i)
+	}
+	var buf bytes.Buffer
+	cache.Snapshot(&buf, GobCodec)
+	restored := NewCache[int, int](2)
+	if err := restored.Restore(&buf, GobCodec); err != nil {
+		t.Fatalf("cache.Restore() error = %v", err)
+	}
+	keys := restored.IterKeys().Collect()
+	if len(keys) != 2 || keys[0] != 4 || keys[1] != 3 {
+		t.Errorf("restored.IterKeys() = %v, want %v", keys, []int{4, 3})
+	}
+}
+
+func TestCacheSnapshotTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := NewCache[int, int](0)
+	cache.SetClock(clock.Now)
+	cache.PutWithTTL(1, 1, time.Minute)
+	cache.PutWithTTL(2, 2, time.Hour)
+	cache.Put(3, 3)
+	cache.PutWithTTL(4, 4, time.Second)
+	clock.Advance(time.Second) // 4 is expired and not saved
+	var buf bytes.Buffer
+	if err := cache.Snapshot(&buf, JSONCodec); err != nil {
+		t.Fatalf("cache.Snapshot() error = %v", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); lines != 3 {
+		t.Errorf("snapshot has %d entries, want %d", lines, 3)
+	}
+	if strings.Count(buf.String(), "expires") != 2 {
+		t.Errorf("snapshot = %s, want the expiration only for the items with a TTL", buf.String())
+	}
+
+	clock.Advance(2 * time.Minute) // 1 is expired when restored
+	restored := NewCache[int, int](0)
+	restored.SetClock(clock.Now)
+	if err := restored.Restore(&buf, JSONCodec); err != nil {
+		t.Fatalf("cache.Restore() error = %v", err)
+	}
+	if restored.Len() != 2 || !restored.Contains(2) || !restored.Contains(3) {
+		t.Errorf("restored.IterKeys() = %v, want %v", restored.IterKeys().Collect(), []int{3, 2})
+	}
+	clock.Advance(time.Hour)
+	if restored.Contains(2) || !restored.Contains(3) {
+		t.Errorf("restored.IterKeys() = %v, want %v", restored.IterKeys().Collect(), []int{3})
+	}
+}
+
+func TestCacheRestoreError(t *testing.T) {
+	cache := NewCache[int, int](0)
+	err := cache.Restore(strings.NewReader(`{"key":1,"value":1}`+"\n"+`{"key":"x"}`), JSONCodec)
+	if err == nil {
+		t.Errorf("cache.Restore() error = %v, want an error", err)
+	}
+	if !cache.Contains(1) {
+		t.Errorf("the items restored before the error must stay in the cache")
+	}
+}
@@ -0,0 +1,201 @@
+package collection
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrLoadPanicked is returned by `SyncCache.GetOrLoad` to the goroutines waiting for a loader that panicked.
+// The panic is propagated only in the goroutine that called the loader.
+var ErrLoadPanicked = errors.New("cache loader panicked")
+
+// SyncCache is a least recently used cache safe for concurrent use.
+// It's a `LRUCache` guarded by a mutex, with a `GetOrLoad` that calls the loader only once
+// for all the goroutines that miss the same key at the same time.
+type SyncCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	cache    *LRUCache[K, V]
+	inflight map[K]*loadCall[V]
+}
+
+// loadCall is a call of a loader in progress, `done` is closed when the loader returns.
+type loadCall[V any] struct {
+	done chan struct{}
+	val  V
+	err  error
+}
+
+// NewSyncCache creates a new SyncCache.
+// If the size is 0 or negative, the cache is unbounded.
+func NewSyncCache[K comparable, V any](size int) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: NewCache[K, V](size), inflight: make(map[K]*loadCall[V])}
+}
+
+// NewWeightedSyncCache creates a new SyncCache whose capacity is the total weight of the items, see `NewWeightedCache`.
+func NewWeightedSyncCache[K comparable, V any](capacity int, weigher func(key K, val V) int) *SyncCache[K, V] {
+	return &SyncCache[K, V]{cache: NewWeightedCache(capacity, weigher), inflight: make(map[K]*loadCall[V])}
+}
+
+// Clear removes all items from the cache.
+func (c *SyncCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Clear()
+}
+
+// Contains checks if the key is in the cache, without changing its recency.
+func (c *SyncCache[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Contains(key)
+}
+
+// Delete removes the key from the cache. It returns false if the key was not in the cache.
+func (c *SyncCache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Delete(key)
+}
+
+// Get returns the value for the given key if present in the cache.
+func (c *SyncCache[K, V]) Get(key K) (val V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+// GetOrLoad returns the value for the given key if present in the cache.
+// If not, it calls `load` and adds the returned value to the cache, unless `load` returns an error.
+//
+// If several goroutines miss the same key at the same time, `load` is called only once
+// and all of them receive the same value and error. Errors are not cached, the next call will load again.
+// `load` is called without holding the lock, so it can use the cache.
+func (c *SyncCache[K, V]) GetOrLoad(key K, load func() (V, error)) (V, error) {
+	c.mu.Lock()
+	if val, ok := c.cache.Get(key); ok {
+		c.mu.Unlock()
+		return val, nil
+	}
+	if call, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.val, call.err
+	}
+	call := &loadCall[V]{done: make(chan struct{})}
+	c.inflight[key] = call
+	c.mu.Unlock()
+
+	// if load panics, the waiting goroutines are released with ErrLoadPanicked
+	call.err = ErrLoadPanicked
+	start := time.Now()
+	defer func() {
+		took := time.Since(start)
+		c.mu.Lock()
+		c.cache.recordLoad(key, took, call.err)
+		delete(c.inflight, key)
+		if call.err == nil {
+			c.cache.Put(key, call.val)
+		}
+		c.mu.Unlock()
+		close(call.done)
+	}()
+	call.val, call.err = load()
+	return call.val, call.err
+}
+
+// Len returns the number of items in the cache.
+func (c *SyncCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+// OnEvict registers a callback that is called with every item removed from the cache.
+// The callback is called while holding the lock of the cache, so it must not use the cache.
+func (c *SyncCache[K, V]) OnEvict(f func(key K, val V, reason EvictionReason)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.OnEvict(f)
+}
+
+// Peek returns the value for the given key if present in the cache, without changing its recency.
+// Peek is not counted in the statistics.
+func (c *SyncCache[K, V]) Peek(key K) (val V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Peek(key)
+}
+
+// PurgeExpired removes all the expired items from the cache and returns how many were removed.
+func (c *SyncCache[K, V]) PurgeExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.PurgeExpired()
+}
+
+// Put adds the given key-value pair to the cache.
+func (c *SyncCache[K, V]) Put(key K, val V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Put(key, val)
+}
+
+// PutWithTTL adds the given key-value pair to the cache, the item expires after `ttl`.
+func (c *SyncCache[K, V]) PutWithTTL(key K, val V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.PutWithTTL(key, val, ttl)
+}
+
+// Resize changes the size of the cache, evicting the least recently used items if they don't fit.
+func (c *SyncCache[K, V]) Resize(size int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Resize(size)
+}
+
+// Restore adds the items of a snapshot to the cache, see `LRUCache.Restore`.
+func (c *SyncCache[K, V]) Restore(r io.Reader, codec Codec) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Restore(r, codec)
+}
+
+// SetHooks registers the functions called on the events of the cache, see `CacheHooks`.
+// The functions are called while holding the lock of the cache, so they must not use the cache.
+func (c *SyncCache[K, V]) SetHooks(hooks CacheHooks[K]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.SetHooks(hooks)
+}
+
+// SetTTL sets the default time-to-live of the items, see `LRUCache.SetTTL`.
+func (c *SyncCache[K, V]) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.SetTTL(ttl)
+}
+
+// Snapshot writes the items of the cache to w, see `LRUCache.Snapshot`.
+// The lock is held while writing, so w should not be slow.
+func (c *SyncCache[K, V]) Snapshot(w io.Writer, codec Codec) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Snapshot(w, codec)
+}
+
+// Stats returns a snapshot of the statistics of the cache.
+func (c *SyncCache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Stats()
+}
+
+// Weight returns the total weight of the items in the cache.
+func (c *SyncCache[K, V]) Weight() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Weight()
+}
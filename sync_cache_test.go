@@ -0,0 +1,113 @@
+package collection
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSyncCacheGetOrLoadOnce(t *testing.T) {
+	cache := NewSyncCache[int, int](10)
+	var calls int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := cache.GetOrLoad(1, func() (int, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return 42, nil
+			})
+			if err != nil || v != 42 {
+				t.Errorf("cache.GetOrLoad(1) = %d, %v, want %d, %v", v, err, 42, nil)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("loader called %d times, want %d", calls, 1)
+	}
+	if v, ok := cache.Get(1); !ok || v != 42 {
+		t.Errorf("cache.Get(1) = %d, %t, want %d, %t", v, ok, 42, true)
+	}
+}
+
+func TestSyncCacheGetOrLoadError(t *testing.T) {
+	cache := NewSyncCache[int, int](10)
+	fail := errors.New("fail")
+	if _, err := cache.GetOrLoad(1, func() (int, error) { return 0, fail }); err != fail {
+		t.Errorf("cache.GetOrLoad(1) error = %v, want %v", err, fail)
+	}
+	if cache.Contains(1) {
+		t.Errorf("errors must not be cached")
+	}
+	if v, err := cache.GetOrLoad(1, func() (int, error) { return 1, nil }); err != nil || v != 1 {
+		t.Errorf("cache.GetOrLoad(1) = %d, %v, want %d, %v", v, err, 1, nil)
+	}
+}
+
+func TestSyncCacheStats(t *testing.T) {
+	cache := NewSyncCache[int, int](1)
+	cache.Put(1, 1)
+	cache.Get(1)
+	cache.Get(2)
+	cache.Put(2, 2)
+	cache.Delete(2)
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 1 || stats.Deletes != 1 {
+		t.Errorf("cache.Stats() = %+v, want 1 hit, 1 miss, 1 eviction, 1 delete", stats)
+	}
+	if stats.HitRatio() != 0.5 {
+		t.Errorf("stats.HitRatio() = %f, want %f", stats.HitRatio(), 0.5)
+	}
+}
+
+func TestSyncCacheLoadStats(t *testing.T) {
+	cache := NewSyncCache[int, int](10)
+	var loaded []error
+	cache.SetHooks(CacheHooks[int]{OnLoad: func(key int, took time.Duration, err error) { loaded = append(loaded, err) }})
+	fail := errors.New("fail")
+	cache.GetOrLoad(1, func() (int, error) { return 0, fail })
+	cache.GetOrLoad(1, func() (int, error) {
+		time.Sleep(time.Millisecond)
+		return 1, nil
+	})
+	cache.GetOrLoad(1, func() (int, error) { return 2, nil })
+	stats := cache.Stats()
+	if stats.Loads != 2 || stats.Puts != 1 || stats.Hits != 1 || stats.Misses != 2 || stats.Weight != 1 {
+		t.Errorf("cache.Stats() = %+v, want 2 loads, 1 put, 1 hit, 2 misses, weight 1", stats)
+	}
+	if stats.LoadTime < time.Millisecond {
+		t.Errorf("stats.LoadTime = %v, want at least %v", stats.LoadTime, time.Millisecond)
+	}
+	if len(loaded) != 2 || loaded[0] != fail || loaded[1] != nil {
+		t.Errorf("loaded = %v, want %v", loaded, []error{fail, nil})
+	}
+}
+
+func TestSyncCacheConcurrent(t *testing.T) {
+	cache := NewSyncCache[int, int](50)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				k := (i * g) % 100
+				cache.GetOrLoad(k, func() (int, error) { return k, nil })
+				cache.Put(k+1, k)
+				cache.Delete(k + 2)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if cache.Len() > 50 {
+		t.Errorf("cache.Len() = %d, want at most %d", cache.Len(), 50)
+	}
+}
@@ -0,0 +1,260 @@
+package collection
+
+import "hash/maphash"
+
+// TinyLFUCache implements the W-TinyLFU cache.
+// New items enter a small LRU window. When they leave the window they are admitted to the main cache
+// only if they were accessed more often than the item the main cache would evict.
+// The access frequency of the keys, including the keys not in the cache, is estimated with a count-min sketch
+// that is periodically halved, so old accesses count less than recent ones.
+// The main cache is a segmented LRU: the items accessed again move from a probation segment to a protected one.
+//
+// It has a high hit ratio for most workloads, including the ones with scans or a frequency that changes over time.
+type TinyLFUCache[K comparable, V any] struct {
+	cacheRecorder[K, V]
+	size int
+	// windowSize is the size of `window`, protectedSize the size of `protected`
+	windowSize, protectedSize int
+	// window, probation and protected are LRU lists, most recent first
+	window, probation, protected DLList[*pentry[K, V]]
+	items                        map[K]*Element[*pentry[K, V]]
+	sketch                       *countMinSketch[K]
+}
+
+const (
+	tinyLFUWindow = iota
+	tinyLFUProbation
+	tinyLFUProtected
+)
+
+// NewTinyLFUCache creates a new TinyLFUCache.
+// The window is 1% of the size and the protected segment is 80% of the rest.
+// The size must be positive, otherwise it panics.
+func NewTinyLFUCache[K comparable, V any](size int) *TinyLFUCache[K, V] {
+	mustBePositive(size)
+	window := max(size/100, 1)
+	return &TinyLFUCache[K, V]{
+		size:          size,
+		windowSize:    window,
+		protectedSize: (size - window) * 8 / 10,
+		items:         make(map[K]*Element[*pentry[K, V]]),
+		sketch:        newCountMinSketch[K](size),
+	}
+}
+
+// Clear removes all items from the cache. The access frequencies are forgotten too.
+func (c *TinyLFUCache[K, V]) Clear() {
+	cleared := c.items
+	c.window.Clear()
+	c.probation.Clear()
+	c.protected.Clear()
+	c.items = make(map[K]*Element[*pentry[K, V]])
+	c.sketch = newCountMinSketch[K](c.size)
+	for key, elem := range cleared {
+		c.evicted(key, elem.Value().val, EvictedDeleted)
+	}
+}
+
+// Contains checks if the key is in the cache, without counting it as an access.
+func (c *TinyLFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Delete removes the key from the cache. It returns false if the key was not in the cache.
+func (c *TinyLFUCache[K, V]) Delete(key K) bool {
+	elem, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.list(elem.Value()).Remove(elem)
+	delete(c.items, key)
+	c.evicted(key, elem.Value().val, EvictedDeleted)
+	return true
+}
+
+// Get returns the value for the given key if present in the cache.
+// Misses count as accesses too, to estimate the frequency of the keys.
+func (c *TinyLFUCache[K, V]) Get(key K) (val V, ok bool) {
+	c.sketch.increment(key)
+	elem, ok := c.items[key]
+	c.recordLookup(key, ok)
+	if !ok {
+		return val, ok
+	}
+	c.touch(elem)
+	return elem.Value().val, ok
+}
+
+// GetOrAdd returns the value for the given key if present in the cache.
+// If not, it adds the value returned by f and returns it.
+func (c *TinyLFUCache[K, V]) GetOrAdd(key K, f func() V) V {
+	if val, ok := c.Get(key); ok {
+		return val
+	}
+	val := c.load(key, f)
+	c.put(key, val)
+	return val
+}
+
+// IsEmpty returns true if the cache is empty.
+func (c *TinyLFUCache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// IsFull returns true if the cache is full.
+func (c *TinyLFUCache[K, V]) IsFull() bool {
+	return len(c.items) >= c.size
+}
+
+// Iter returns an iterator over the key/value pairs in the cache.
+// The protected items are returned first, then the items on probation and last the items of the window,
+// each segment from the most recently used to the least one.
+func (c *TinyLFUCache[K, V]) Iter() Iterator2[K, V] {
+	return iterEntries(&c.protected, &c.probation, &c.window)
+}
+
+// Len returns the number of items in the cache.
+func (c *TinyLFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Peek returns the value for the given key if present in the cache, without counting it as an access.
+func (c *TinyLFUCache[K, V]) Peek(key K) (val V, ok bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return val, ok
+	}
+	return elem.Value().val, ok
+}
+
+// Put adds the given key-value pair to the cache, it counts as an access of the key.
+// The new item is added to the window, so it is in the cache at least until it leaves the window.
+func (c *TinyLFUCache[K, V]) Put(key K, val V) {
+	c.sketch.increment(key)
+	c.put(key, val)
+}
+
+// Stats returns a snapshot of the statistics of the cache. The weight is the number of items.
+func (c *TinyLFUCache[K, V]) Stats() CacheStats {
+	return c.snapshot(len(c.items))
+}
+
+// admit moves the oldest item of the window to the main cache, if it's more frequent than
+// the item the main cache would evict, otherwise the item is evicted.
+func (c *TinyLFUCache[K, V]) admit() {
+	candidate := c.window.BackElement()
+	c.window.Remove(candidate)
+	entry := candidate.Value()
+	if len(c.items) > c.size {
+		victim := c.probation.BackElement()
+		if victim == nil {
+			victim = c.protected.BackElement()
+		}
+		if victim == nil || c.sketch.estimate(entry.key) <= c.sketch.estimate(victim.Value().key) {
+			delete(c.items, entry.key)
+			c.evicted(entry.key, entry.val, EvictedCapacity)
+			return
+		}
+		c.list(victim.Value()).Remove(victim)
+		delete(c.items, victim.Value().key)
+		c.evicted(victim.Value().key, victim.Value().val, EvictedCapacity)
+	}
+	entry.list = tinyLFUProbation
+	c.items[entry.key] = c.probation.PushFront(entry)
+}
+
+// list returns the segment holding the entry.
+func (c *TinyLFUCache[K, V]) list(entry *pentry[K, V]) *DLList[*pentry[K, V]] {
+	switch entry.list {
+	case tinyLFUWindow:
+		return &c.window
+	case tinyLFUProbation:
+		return &c.probation
+	}
+	return &c.protected
+}
+
+// put adds or updates the item, without counting the access.
+func (c *TinyLFUCache[K, V]) put(key K, val V) {
+	c.recordPut(key)
+	if elem, ok := c.items[key]; ok {
+		elem.Value().val = val
+		c.touch(elem)
+		return
+	}
+	entry := &pentry[K, V]{key: key, val: val, list: tinyLFUWindow}
+	c.items[key] = c.window.PushFront(entry)
+	if c.window.Len() > c.windowSize {
+		c.admit()
+	}
+}
+
+// touch moves the entry at the front of its segment, an entry on probation is moved to the protected segment.
+// When the protected segment is over its size, its least recently used entry goes back on probation.
+func (c *TinyLFUCache[K, V]) touch(elem *Element[*pentry[K, V]]) {
+	entry := elem.Value()
+	if entry.list != tinyLFUProbation {
+		c.list(entry).MoveToFront(elem)
+		return
+	}
+	c.probation.Remove(elem)
+	entry.list = tinyLFUProtected
+	c.items[entry.key] = c.protected.PushFront(entry)
+	if c.protected.Len() > c.protectedSize {
+		demoted, _ := c.protected.PopBack()
+		demoted.list = tinyLFUProbation
+		c.items[demoted.key] = c.probation.PushFront(demoted)
+	}
+}
+
+// countMinSketch estimates the frequency of the keys with 4 rows of 4-bit counters.
+// After `10*size` increments all the counters are halved.
+type countMinSketch[K comparable] struct {
+	seed       maphash.Seed
+	rows       [4][]uint8
+	mask       uint64
+	increments int
+	resetAt    int
+}
+
+func newCountMinSketch[K comparable](size int) *countMinSketch[K] {
+	width := 16
+	for width < size {
+		width *= 2
+	}
+	s := &countMinSketch[K]{seed: maphash.MakeSeed(), mask: uint64(width - 1), resetAt: 10 * size}
+	for i := range s.rows {
+		s.rows[i] = make([]uint8, width)
+	}
+	return s
+}
+
+// estimate returns the estimated frequency of the key.
+func (s *countMinSketch[K]) estimate(key K) uint8 {
+	h1, h2 := s.hash(key)
+	freq := uint8(15)
+	for i := range s.rows {
+		freq = min(freq, s.rows[i][(h1+uint64(i)*h2)&s.mask])
+	}
+	return freq
+}
+
+// increment counts an access of the key.
+func (s *countMinSketch[K]) increment(key K) {
+	h1, h2 := s.hash(key)
+	for i := range s.rows {
+		if counter := &s.rows[i][(h1+uint64(i)*h2)&s.mask]; *counter < 15 {
+			*counter++
+		}
+	}
+	s.increments++
+	if s.increments >= s.resetAt {
+		s.increments /= 2
+		for i := range s.rows {
+			for j := range s.rows[i] {
+				s.rows[i][j] /= 2
+			}
+		}
+	}
+}
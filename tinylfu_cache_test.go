@@ -0,0 +1,66 @@
+package collection
+
+import "testing"
+
+func TestTinyLFUCacheResistsScans(t *testing.T) {
+	cache := NewTinyLFUCache[int, int](100)
+	for range 5 {
+		for i := range 50 {
+			cache.Put(i, i)
+			cache.Get(i)
+		}
+	}
+	for i := 1000; i < 2000; i++ {
+		cache.Put(i, i)
+	}
+	// 49 never left the window, so it is not protected from the scan
+	for i := range 49 {
+		if !cache.Contains(i) {
+			t.Errorf("cache.Contains(%d) = %t, want %t", i, false, true)
+		}
+	}
+	if cache.Len() != 100 {
+		t.Errorf("cache.Len() = %d, want %d", cache.Len(), 100)
+	}
+}
+
+func TestTinyLFUCacheSizeOne(t *testing.T) {
+	cache := NewTinyLFUCache[int, int](1)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	if cache.Contains(1) || !cache.Contains(2) {
+		t.Errorf("cache.Iter() = %v, want only %d", cache.Iter().Keys().Collect(), 2)
+	}
+}
+
+func TestCountMinSketch(t *testing.T) {
+	sketch := newCountMinSketch[int](1000)
+	for range 5 {
+		sketch.increment(1)
+	}
+	if f := sketch.estimate(1); f < 5 {
+		t.Errorf("sketch.estimate(1) = %d, want at least %d", f, 5)
+	}
+	for range 10000 {
+		sketch.increment(2)
+	}
+	if f := sketch.estimate(1); f >= 5 {
+		t.Errorf("sketch.estimate(1) = %d after the reset, want less than %d", f, 5)
+	}
+}
+
+func TestCountMinSketchKeyTypes(t *testing.T) {
+	type point struct{ x, y int }
+	sketch := newCountMinSketch[point](1000)
+	for range 3 {
+		sketch.increment(point{1, 2})
+	}
+	if f := sketch.estimate(point{1, 2}); f != 3 {
+		t.Errorf("sketch.estimate({1, 2}) = %d, want %d", f, 3)
+	}
+	strings := newCountMinSketch[string](1000)
+	strings.increment("a")
+	if f := strings.estimate("a"); f != 1 {
+		t.Errorf("sketch.estimate(a) = %d, want %d", f, 1)
+	}
+}
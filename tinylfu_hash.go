@@ -0,0 +1,11 @@
+//go:build go1.24
+
+package collection
+
+import "hash/maphash"
+
+// hash returns two hashes of the key, the index of every row is derived from them.
+func (s *countMinSketch[K]) hash(key K) (uint64, uint64) {
+	h := maphash.Comparable(s.seed, key)
+	return h, h>>32 | 1
+}
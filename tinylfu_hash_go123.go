@@ -0,0 +1,38 @@
+//go:build !go1.24
+
+package collection
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+)
+
+// hash returns two hashes of the key, the index of every row is derived from them.
+// Before Go 1.24 there is no `maphash.Comparable`: strings and integers are hashed directly,
+// the other keys are hashed by their `fmt` representation.
+func (s *countMinSketch[K]) hash(key K) (uint64, uint64) {
+	var h maphash.Hash
+	h.SetSeed(s.seed)
+	var buf [8]byte
+	switch k := any(key).(type) {
+	case string:
+		h.WriteString(k)
+	case int:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(k)))
+	case int32:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(k)))
+	case int64:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(k)))
+	case uint:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(k)))
+	case uint32:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], uint64(k)))
+	case uint64:
+		h.Write(binary.LittleEndian.AppendUint64(buf[:0], k))
+	default:
+		fmt.Fprint(&h, key)
+	}
+	sum := h.Sum64()
+	return sum, sum>>32 | 1
+}
@@ -0,0 +1,140 @@
+package collection
+
+import "context"
+
+// TryIterator is a lazy iterator over values whose source can fail, like a file or a database cursor.
+// It follows the same rules of `Iterator`, with an extra error value:
+// when the error is not nil the iteration has failed, the second returned value is `false`
+// and the zero value of the type `T` is returned.
+// Consecutive calls after the first time `false` is returned, should return the same values, error included.
+//
+// All the operations stop at the first error and return it.
+type TryIterator[T any] func() (T, bool, error)
+
+// Collect will consume the iterator and return a `Vec` with all the values.
+// If the iteration fails, the values collected until then are returned together with the error.
+func (it TryIterator[T]) Collect() (Vec[T], error) {
+	var vec Vec[T]
+	i, ok, err := it()
+	for ; ok; i, ok, err = it() {
+		vec = append(vec, i)
+	}
+	return vec, err
+}
+
+// Count will consume the iterator and return the number of values iterated.
+func (it TryIterator[T]) Count() (int, error) {
+	var c int
+	_, ok, err := it()
+	for ; ok; _, ok, err = it() {
+		c++
+	}
+	return c, err
+}
+
+// Filter will pass only the values that satisfy the test.
+func (it TryIterator[T]) Filter(test func(item T) bool) TryIterator[T] {
+	return func() (T, bool, error) {
+		i, ok, err := it()
+		for ; ok; i, ok, err = it() {
+			if test(i) {
+				return i, ok, nil
+			}
+		}
+		return *new(T), false, err
+	}
+}
+
+// Find will try to find a value that satisfies the test.
+// The second returned value is true if a value was found, false otherwise.
+func (it TryIterator[T]) Find(test func(item T) bool) (T, bool, error) {
+	i, ok, err := it()
+	for ; ok; i, ok, err = it() {
+		if test(i) {
+			return i, ok, nil
+		}
+	}
+	return *new(T), false, err
+}
+
+// ForEach will consume the iterator and run the action with every value.
+// It stops at the first error, either from the iterator or returned by the action.
+func (it TryIterator[T]) ForEach(action func(item T) error) error {
+	i, ok, err := it()
+	for ; ok; i, ok, err = it() {
+		if err := action(i); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// Iter returns an infallible `Iterator` over the values, which ends at the first error,
+// and a function that returns that error, if any.
+//
+//	lines, errFn := it.Iter()
+//	lines.Filter(notEmpty).ForEach(process)
+//	if err := errFn(); err != nil {
+//		return err
+//	}
+func (it TryIterator[T]) Iter() (Iterator[T], func() error) {
+	var err error
+	return func() (T, bool) {
+			if err != nil {
+				return *new(T), false
+			}
+			var i T
+			var ok bool
+			i, ok, err = it()
+			return i, ok
+		}, func() error {
+			return err
+		}
+}
+
+// Skip will skip the first `count` values.
+func (it TryIterator[T]) Skip(count int) TryIterator[T] {
+	skipped := false
+	return func() (T, bool, error) {
+		if skipped {
+			return it()
+		}
+		skipped = true
+		for i := 0; i < count; i++ {
+			if _, ok, err := it(); !ok {
+				return *new(T), false, err
+			}
+		}
+		return it()
+	}
+}
+
+// Take will yield at most the first `count` values.
+func (it TryIterator[T]) Take(count int) TryIterator[T] {
+	taken := 0
+	return func() (T, bool, error) {
+		if taken >= count {
+			return *new(T), false, nil
+		}
+		i, ok, err := it()
+		if ok {
+			taken++
+			return i, ok, nil
+		}
+		if err == nil {
+			taken = count
+		}
+		return *new(T), false, err
+	}
+}
+
+// WithContext returns an iterator that ends as soon as the context is done.
+// The context is checked before pulling every value, once it is done the iterator fails with the context error.
+func (it TryIterator[T]) WithContext(ctx context.Context) TryIterator[T] {
+	return func() (T, bool, error) {
+		if err := ctx.Err(); err != nil {
+			return *new(T), false, err
+		}
+		return it()
+	}
+}
@@ -0,0 +1,80 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+// failingAt returns a TryIterator over 1, 2, 3... that fails instead of yielding `at`.
+func failingAt(at int) TryIterator[int] {
+	i := 0
+	return func() (int, bool, error) {
+		if i+1 >= at {
+			return 0, false, errTest
+		}
+		i++
+		return i, true, nil
+	}
+}
+
+func TestTryCollect(t *testing.T) {
+	v, err := failingAt(4).Collect()
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+	if v.Len() != 3 {
+		t.Errorf("expected 3, got %d", v.Len())
+	}
+	v, err = Vec[int]{1, 2, 3}.Iter().Try().Collect()
+	if err != nil || v.Len() != 3 {
+		t.Errorf("expected 3, nil, got %d, %v", v.Len(), err)
+	}
+}
+
+func TestTryFilter(t *testing.T) {
+	c, err := failingAt(6).Filter(func(i int) bool { return i%2 == 0 }).Count()
+	if c != 2 || !errors.Is(err, errTest) {
+		t.Errorf("expected 2, %v, got %d, %v", errTest, c, err)
+	}
+}
+
+func TestTryTake(t *testing.T) {
+	v, err := failingAt(6).Take(3).Collect()
+	if err != nil || v.Len() != 3 {
+		t.Errorf("expected 3, nil, got %d, %v", v.Len(), err)
+	}
+	_, err = failingAt(2).Skip(1).Take(3).Collect()
+	if !errors.Is(err, errTest) {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+}
+
+func TestTryForEach(t *testing.T) {
+	stop := errors.New("stop")
+	sum := 0
+	err := Vec[int]{1, 2, 3}.Iter().Try().ForEach(func(i int) error {
+		if i == 3 {
+			return stop
+		}
+		sum += i
+		return nil
+	})
+	if sum != 3 || err != stop {
+		t.Errorf("expected 3, %v, got %d, %v", stop, sum, err)
+	}
+}
+
+func TestTryIter(t *testing.T) {
+	it, errFn := failingAt(4).Iter()
+	if c := it.Count(); c != 3 {
+		t.Errorf("expected 3, got %d", c)
+	}
+	if _, ok := it(); ok {
+		t.Errorf("expected !ok after the error")
+	}
+	if !errors.Is(errFn(), errTest) {
+		t.Errorf("expected %v, got %v", errTest, errFn())
+	}
+}
@@ -0,0 +1,178 @@
+package collection
+
+// TwoQueueCache implements the 2Q cache.
+// New items enter a small FIFO queue, only the items requested again after leaving it
+// are admitted to the main LRU queue, so a scan of new keys will not evict the items used frequently.
+// The keys evicted from the FIFO queue are remembered, a key requested again while remembered is admitted to the main queue.
+type TwoQueueCache[K comparable, V any] struct {
+	cacheRecorder[K, V]
+	size int
+	// recentSize is the size of `recent`, ghostSize the size of `ghosts`
+	recentSize, ghostSize int
+	// recent is the FIFO queue of the new items, frequent the LRU queue. Most recent first.
+	recent, frequent DLList[*pentry[K, V]]
+	items            map[K]*Element[*pentry[K, V]]
+	// ghosts holds the keys recently evicted from `recent`.
+	ghosts    DLList[K]
+	ghostKeys map[K]*Element[K]
+}
+
+const (
+	twoQueueRecent = iota
+	twoQueueFrequent
+)
+
+// NewTwoQueueCache creates a new TwoQueueCache.
+// A quarter of the size is reserved for the new items and the keys of the last half size evicted items are remembered.
+// The size must be positive, otherwise it panics.
+func NewTwoQueueCache[K comparable, V any](size int) *TwoQueueCache[K, V] {
+	mustBePositive(size)
+	return &TwoQueueCache[K, V]{
+		size:       size,
+		recentSize: max(size/4, 1),
+		ghostSize:  max(size/2, 1),
+		items:      make(map[K]*Element[*pentry[K, V]]),
+		ghostKeys:  make(map[K]*Element[K]),
+	}
+}
+
+// Clear removes all items from the cache.
+func (c *TwoQueueCache[K, V]) Clear() {
+	cleared := c.items
+	c.recent.Clear()
+	c.frequent.Clear()
+	c.ghosts.Clear()
+	c.items = make(map[K]*Element[*pentry[K, V]])
+	c.ghostKeys = make(map[K]*Element[K])
+	for key, elem := range cleared {
+		c.evicted(key, elem.Value().val, EvictedDeleted)
+	}
+}
+
+// Contains checks if the key is in the cache, without counting it as an access.
+func (c *TwoQueueCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Delete removes the key from the cache. It returns false if the key was not in the cache.
+func (c *TwoQueueCache[K, V]) Delete(key K) bool {
+	elem, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.list(elem.Value()).Remove(elem)
+	delete(c.items, key)
+	c.evicted(key, elem.Value().val, EvictedDeleted)
+	return true
+}
+
+// Get returns the value for the given key if present in the cache.
+// Accessing a new item does not change its position in the FIFO queue.
+func (c *TwoQueueCache[K, V]) Get(key K) (val V, ok bool) {
+	elem, ok := c.items[key]
+	c.recordLookup(key, ok)
+	if !ok {
+		return val, ok
+	}
+	if elem.Value().list == twoQueueFrequent {
+		c.frequent.MoveToFront(elem)
+	}
+	return elem.Value().val, ok
+}
+
+// GetOrAdd returns the value for the given key if present in the cache.
+// If not, it adds the value returned by f and returns it.
+func (c *TwoQueueCache[K, V]) GetOrAdd(key K, f func() V) V {
+	if val, ok := c.Get(key); ok {
+		return val
+	}
+	val := c.load(key, f)
+	c.Put(key, val)
+	return val
+}
+
+// IsEmpty returns true if the cache is empty.
+func (c *TwoQueueCache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// IsFull returns true if the cache is full.
+func (c *TwoQueueCache[K, V]) IsFull() bool {
+	return len(c.items) >= c.size
+}
+
+// Iter returns an iterator over the key/value pairs in the cache.
+// The items of the main queue are returned first, each queue from the most recent item to the oldest one.
+func (c *TwoQueueCache[K, V]) Iter() Iterator2[K, V] {
+	return iterEntries(&c.frequent, &c.recent)
+}
+
+// Len returns the number of items in the cache.
+func (c *TwoQueueCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Peek returns the value for the given key if present in the cache, without counting it as an access.
+func (c *TwoQueueCache[K, V]) Peek(key K) (val V, ok bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return val, ok
+	}
+	return elem.Value().val, ok
+}
+
+// Put adds the given key-value pair to the cache, it counts as an access of the key.
+func (c *TwoQueueCache[K, V]) Put(key K, val V) {
+	c.recordPut(key)
+	if elem, ok := c.items[key]; ok {
+		elem.Value().val = val
+		if elem.Value().list == twoQueueFrequent {
+			c.frequent.MoveToFront(elem)
+		}
+		return
+	}
+	entry := &pentry[K, V]{key: key, val: val, list: twoQueueRecent}
+	if ghost, ok := c.ghostKeys[key]; ok {
+		c.ghosts.Remove(ghost)
+		delete(c.ghostKeys, key)
+		entry.list = twoQueueFrequent
+	}
+	c.reclaim()
+	c.items[key] = c.list(entry).PushFront(entry)
+}
+
+// Stats returns a snapshot of the statistics of the cache. The weight is the number of items.
+func (c *TwoQueueCache[K, V]) Stats() CacheStats {
+	return c.snapshot(len(c.items))
+}
+
+// list returns the queue holding the entry.
+func (c *TwoQueueCache[K, V]) list(entry *pentry[K, V]) *DLList[*pentry[K, V]] {
+	if entry.list == twoQueueRecent {
+		return &c.recent
+	}
+	return &c.frequent
+}
+
+// reclaim makes room for a new item if the cache is full.
+// The oldest new item is evicted if the FIFO queue is over its size, otherwise the least recently used item.
+func (c *TwoQueueCache[K, V]) reclaim() {
+	if len(c.items) < c.size {
+		return
+	}
+	if c.recent.Len() > c.recentSize || c.frequent.Len() == 0 {
+		entry, _ := c.recent.PopBack()
+		delete(c.items, entry.key)
+		c.ghostKeys[entry.key] = c.ghosts.PushFront(entry.key)
+		if c.ghosts.Len() > c.ghostSize {
+			key, _ := c.ghosts.PopBack()
+			delete(c.ghostKeys, key)
+		}
+		c.evicted(entry.key, entry.val, EvictedCapacity)
+		return
+	}
+	entry, _ := c.frequent.PopBack()
+	delete(c.items, entry.key)
+	c.evicted(entry.key, entry.val, EvictedCapacity)
+}
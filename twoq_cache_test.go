@@ -0,0 +1,37 @@
+package collection
+
+import "testing"
+
+func TestTwoQueueCacheResistsScans(t *testing.T) {
+	cache := NewTwoQueueCache[int, int](8)
+	for i := range 4 {
+		cache.Put(i, i)
+	}
+	for i := 100; i < 108; i++ {
+		cache.Put(i, i) // 0..3 are evicted from the recent queue and remembered
+	}
+	for i := range 4 {
+		cache.Put(i, i) // they are promoted to the frequent queue
+	}
+	for i := 200; i < 300; i++ {
+		cache.Put(i, i)
+	}
+	for i := range 4 {
+		if v, ok := cache.Get(i); !ok || v != i {
+			t.Errorf("cache.Get(%d) = %d, %t, want %d, %t", i, v, ok, i, true)
+		}
+	}
+}
+
+func TestTwoQueueCacheRecentIsFIFO(t *testing.T) {
+	cache := NewTwoQueueCache[int, int](4)
+	cache.Put(1, 1)
+	cache.Put(2, 2)
+	cache.Get(1) // hits in the recent queue don't change the order
+	for i := 3; i <= 5; i++ {
+		cache.Put(i, i)
+	}
+	if cache.Contains(1) {
+		t.Errorf("cache.Contains(1) = %t, want %t", true, false)
+	}
+}
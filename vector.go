@@ -1,5 +1,12 @@
 package collection
 
+import (
+	"iter"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
 // Vec is a generic slice, the same rules of the native slice aplly also to `Vec`.
 type Vec[T any] []T
 
@@ -35,6 +42,23 @@ func (v Vec[T]) AppendIter(it Iterator[T]) Vec[T] {
 	return v
 }
 
+// Cursor returns a new cursor over the slice, positioned before the first item.
+func (v Vec[T]) Cursor() *VecCursor[T] {
+	return &VecCursor[T]{vec: v, pos: -1}
+}
+
+// Enumerate will return a lazy iterator over the indexes and values of the slice.
+func (v Vec[T]) Enumerate() Iterator2[int, T] {
+	current := 0
+	return func() (int, T, bool) {
+		if current < len(v) {
+			current++
+			return current - 1, v[current-1], true
+		}
+		return 0, *new(T), false
+	}
+}
+
 // Iter will return a lazy iterator over the values of the slice.
 func (v Vec[T]) Iter() Iterator[T] {
 	current := 0
@@ -63,3 +87,137 @@ func (v Vec[T]) ReverseIter() Iterator[T] {
 		return *new(T), false
 	}
 }
+
+// All returns an `iter.Seq2` over the indexes and values of the slice.
+func (v Vec[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, val := range v {
+			if !yield(i, val) {
+				return
+			}
+		}
+	}
+}
+
+// Seq returns an `iter.Seq` over the values of the slice.
+func (v Vec[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range v {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Clip removes the unused capacity of the slice. It's an alias to `slices.Clip`.
+// Remember to assign the returned value.
+func (v Vec[T]) Clip() Vec[T] {
+	return slices.Clip(v)
+}
+
+// Clone returns a copy of the slice, the returned slice does not share the backing array with `v`.
+func (v Vec[T]) Clone() Vec[T] {
+	return slices.Clone(v)
+}
+
+// CompactFunc replaces consecutive runs of equal items with a single copy, the first one of the run.
+// The slice is modified in place, remember to assign the returned value.
+func (v Vec[T]) CompactFunc(eq func(a, b T) bool) Vec[T] {
+	return slices.CompactFunc(v, eq)
+}
+
+// Grow increases the capacity of the slice, if needed, to guarantee space for another `n` items.
+// Remember to assign the returned value, as you should do with `append`.
+func (v Vec[T]) Grow(n int) Vec[T] {
+	return slices.Grow(v, n)
+}
+
+// IndexFunc returns the index of the first item that satisfies the test, or -1 if none does.
+func (v Vec[T]) IndexFunc(test func(item T) bool) int {
+	return slices.IndexFunc(v, test)
+}
+
+// Insert will insert the items at the index `i`, moving the following items to the right.
+// It panics if `i` is out of range. Remember to assign the returned value, as you should do with `append`.
+func (v Vec[T]) Insert(i int, items ...T) Vec[T] {
+	return slices.Insert(v, i, items...)
+}
+
+// RemoveAt removes the item at the index `i`, moving the following items to the left.
+// It panics if `i` is out of range. Remember to assign the returned value.
+func (v Vec[T]) RemoveAt(i int) Vec[T] {
+	return slices.Delete(v, i, i+1)
+}
+
+// RemoveRange removes the items `v[i:j]`, moving the following items to the left.
+// It panics if `v[i:j]` is not a valid slice. Remember to assign the returned value.
+func (v Vec[T]) RemoveRange(i, j int) Vec[T] {
+	return slices.Delete(v, i, j)
+}
+
+// Retain keeps only the items that satisfy the test, in the same order.
+// The slice is modified in place, remember to assign the returned value.
+func (v Vec[T]) Retain(test func(item T) bool) Vec[T] {
+	return slices.DeleteFunc(v, func(item T) bool { return !test(item) })
+}
+
+// Reverse reverses the items of the slice in place.
+func (v Vec[T]) Reverse() {
+	slices.Reverse(v)
+}
+
+// SortBy sorts the slice in place by `less`. The sort is not stable, use `SortStable` when it's needed.
+func (v Vec[T]) SortBy(less func(a, b T) bool) {
+	slices.SortFunc(v, lessToCmp(less))
+}
+
+// SortStable sorts the slice in place by `less`, equal items keep their order.
+func (v Vec[T]) SortStable(less func(a, b T) bool) {
+	slices.SortStableFunc(v, lessToCmp(less))
+}
+
+// Swap swaps the items at the indexes `i` and `j`.
+func (v Vec[T]) Swap(i, j int) {
+	v[i], v[j] = v[j], v[i]
+}
+
+// BinarySearch searches `target` in the sorted slice and returns the index where it is found,
+// or where it would be inserted, and whether it was found.
+func BinarySearch[T constraints.Ordered](v Vec[T], target T) (int, bool) {
+	return slices.BinarySearch(v, target)
+}
+
+// Compact replaces consecutive runs of equal items with a single copy.
+// The slice is modified in place, remember to assign the returned value.
+func Compact[T comparable](v Vec[T]) Vec[T] {
+	return slices.Compact(v)
+}
+
+// Contains checks if the item is in the slice.
+func Contains[T comparable](v Vec[T], item T) bool {
+	return slices.Contains(v, item)
+}
+
+// IndexOf returns the index of the first occurrence of the item in the slice, or -1 if not present.
+func IndexOf[T comparable](v Vec[T], item T) int {
+	return slices.Index(v, item)
+}
+
+// Sort sorts the slice in place, in increasing order.
+func Sort[T constraints.Ordered](v Vec[T]) {
+	slices.Sort(v)
+}
+
+// lessToCmp adapts a less function to the comparison function of the `slices` package.
+func lessToCmp[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	}
+}
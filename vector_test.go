@@ -62,3 +62,93 @@ func TestReverseIter(t *testing.T) {
 		t.Errorf("expected 0, got %d", v)
 	}
 }
+
+func TestVecAll(t *testing.T) {
+	a := Vec[int]{4, 5, 6}
+	count := 0
+	for i, v := range a.All() {
+		if a[i] != v {
+			t.Errorf("expected %d at %d, got %d", a[i], i, v)
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("expected 3, got %d", count)
+	}
+}
+
+func TestInsertRemove(t *testing.T) {
+	a := Vec[int]{1, 4}
+	a = a.Insert(1, 2, 3)
+	for i, v := range a {
+		if v != i+1 {
+			t.Errorf("expected %d at %d, got %d", i+1, i, v)
+		}
+	}
+	a = a.RemoveAt(0)
+	if a.Len() != 3 || a[0] != 2 {
+		t.Errorf("expected [2 3 4], got %v", a)
+	}
+	a = a.RemoveRange(1, 3)
+	if a.Len() != 1 || a[0] != 2 {
+		t.Errorf("expected [2], got %v", a)
+	}
+}
+
+func TestRetain(t *testing.T) {
+	a := Vec[int]{1, 2, 3, 4, 5, 6}
+	a = a.Retain(func(i int) bool { return i%2 == 0 })
+	if a.Len() != 3 || a[0] != 2 || a[2] != 6 {
+		t.Errorf("expected [2 4 6], got %v", a)
+	}
+}
+
+func TestReverseAndClone(t *testing.T) {
+	a := Vec[int]{1, 2, 3}
+	b := a.Clone()
+	a.Reverse()
+	if a[0] != 3 || a[2] != 1 {
+		t.Errorf("expected [3 2 1], got %v", a)
+	}
+	if b[0] != 1 {
+		t.Errorf("expected the clone to be unchanged, got %v", b)
+	}
+	b.Swap(0, 2)
+	if b[0] != 3 || b[2] != 1 {
+		t.Errorf("expected [3 2 1], got %v", b)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	a := Compact(Vec[int]{1, 1, 2, 2, 2, 1})
+	if a.Len() != 3 || a[2] != 1 {
+		t.Errorf("expected [1 2 1], got %v", a)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	a := Vec[int]{5, 3, 1, 4}
+	if IndexOf(a, 1) != 2 || IndexOf(a, 9) != -1 {
+		t.Errorf("unexpected IndexOf results for %v", a)
+	}
+	if !Contains(a, 4) || Contains(a, 9) {
+		t.Errorf("unexpected Contains results for %v", a)
+	}
+	Sort(a)
+	if i, ok := BinarySearch(a, 4); !ok || i != 2 {
+		t.Errorf("expected 2, true, got %d, %v", i, ok)
+	}
+	if i, ok := BinarySearch(a, 2); ok || i != 1 {
+		t.Errorf("expected 1, false, got %d, %v", i, ok)
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	a := Vec[string]{"bb", "a", "cc", "d"}
+	a.SortStable(func(x, y string) bool { return len(x) < len(y) })
+	for i, w := range []string{"a", "d", "bb", "cc"} {
+		if a[i] != w {
+			t.Errorf("expected %s at %d, got %s", w, i, a[i])
+		}
+	}
+}